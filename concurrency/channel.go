@@ -0,0 +1,51 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// Merge fans in values from all channels into a single channel.
+// The returned channel is closed once every input channel has been closed.
+// Values from the same input keep their relative order; there is no ordering across inputs.
+func Merge[T any](channels ...<-chan T) <-chan T {
+	return MergeCtx(context.Background(), channels...)
+}
+
+// MergeCtx is like Merge but stops forwarding and closes the output when ctx is done.
+// Input channels are not drained after cancellation, so their producers should also
+// observe ctx to avoid blocking forever.
+func MergeCtx[T any](ctx context.Context, channels ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(channels))
+	for _, ch := range channels {
+		go func(ch <-chan T) {
+			defer wg.Done()
+			for {
+				select {
+				case v, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
@@ -0,0 +1,54 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"context"
+	"fmt"
+)
+
+// Future holds the result of an asynchronous computation started with Go
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// Go runs fn in a new goroutine and returns a Future for its result
+// A panic in fn is recovered and reported as the Future's error
+func Go[T any](fn func() (T, error)) *Future[T] {
+	f := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		defer func() {
+			if r := recover(); r != nil {
+				f.err = fmt.Errorf("task panicked: %v", r)
+			}
+		}()
+		f.value, f.err = fn()
+	}()
+	return f
+}
+
+// Get blocks until the computation finishes and returns its result
+// It may be called any number of times, from any goroutine, and always returns the same result
+func (f *Future[T]) Get() (T, error) {
+	<-f.done
+	return f.value, f.err
+}
+
+// GetCtx is like Get but stops waiting when ctx is done, returning ctx.Err()
+// The computation itself keeps running, and a later Get can still collect its result
+func (f *Future[T]) GetCtx(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Done returns a channel that is closed when the computation finishes
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
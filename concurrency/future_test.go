@@ -0,0 +1,66 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFutureGetFromManyGoroutines(t *testing.T) {
+	calls := 0
+	f := Go(func() (int, error) {
+		calls++
+		time.Sleep(5 * time.Millisecond)
+		return 42, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := f.Get(); v != 42 || err != nil {
+				t.Errorf("Get() = %v, %v; want 42, nil", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("fn ran %d times, want 1", calls)
+	}
+}
+
+func TestFutureGetCtxCancel(t *testing.T) {
+	release := make(chan struct{})
+	f := Go(func() (string, error) {
+		<-release
+		return "done", nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if v, err := f.GetCtx(ctx); !errors.Is(err, context.DeadlineExceeded) || v != "" {
+		t.Errorf("GetCtx() = %q, %v; want zero value and context.DeadlineExceeded", v, err)
+	}
+
+	select {
+	case <-f.Done():
+		t.Fatal("future finished before fn returned")
+	default:
+	}
+
+	close(release)
+	if v, err := f.Get(); v != "done" || err != nil {
+		t.Errorf("Get() after GetCtx timed out = %q, %v; want done, nil", v, err)
+	}
+}
+
+func TestFuturePanic(t *testing.T) {
+	f := Go(func() (int, error) { panic("boom") })
+	if _, err := f.Get(); err == nil {
+		t.Error("Get() returned nil error for a panicking fn")
+	}
+}
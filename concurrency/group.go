@@ -0,0 +1,95 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// RunAll runs funcs with at most limit running concurrently (no limit if limit <= 0).
+// The first error cancels the context passed to the remaining functions; RunAll waits for
+// all started functions to return and then reports that first error. If ctx is done before
+// every function has started, the remaining ones are skipped and ctx.Err() is returned; if every
+// function ran and succeeded, RunAll returns nil even if ctx was cancelled afterwards.
+func RunAll(ctx context.Context, limit int, funcs ...func(context.Context) error) error {
+	if limit <= 0 || limit > len(funcs) {
+		limit = len(funcs)
+	}
+	if limit == 0 {
+		return nil
+	}
+
+	groupCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once     sync.Once
+		firstErr error
+	)
+	sem := NewSemaphore(limit)
+
+	skipped := false
+	for _, fn := range funcs {
+		if err := sem.AcquireCtx(groupCtx, 1); err != nil {
+			skipped = true
+			break
+		}
+		// AcquireCtx may pick a free permit even though groupCtx is already done
+		if groupCtx.Err() != nil {
+			sem.Release()
+			skipped = true
+			break
+		}
+		go func(fn func(context.Context) error) {
+			defer sem.Release()
+			if err := runTask(func() error { return fn(groupCtx) }); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(fn)
+	}
+
+	sem.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	if skipped {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// ForEach calls fn for every item with at most concurrency calls running at once
+// (no limit if concurrency <= 0). It waits for all calls and returns their joined errors.
+func ForEach[T any](items []T, concurrency int, fn func(T) error) error {
+	if concurrency <= 0 || concurrency > len(items) {
+		concurrency = len(items)
+	}
+	if concurrency == 0 {
+		return nil
+	}
+
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := NewSemaphore(concurrency)
+
+	for _, item := range items {
+		sem.Acquire(1)
+		go func(item T) {
+			defer sem.Release()
+			if err := runTask(func() error { return fn(item) }); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(item)
+	}
+
+	sem.Wait()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,53 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunAllCancelAfterAllStarted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := RunAll(ctx, 2,
+		func(context.Context) error { return nil },
+		func(context.Context) error { cancel(); return nil },
+	)
+	if err != nil {
+		t.Errorf("RunAll returned %v, want nil when every function ran", err)
+	}
+}
+
+func TestRunAllSkipsAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var ran int32
+	funcs := []func(context.Context) error{
+		func(context.Context) error { atomic.AddInt32(&ran, 1); cancel(); return nil },
+	}
+	for i := 0; i < 10; i++ {
+		funcs = append(funcs, func(context.Context) error { atomic.AddInt32(&ran, 1); return nil })
+	}
+
+	err := RunAll(ctx, 1, funcs...)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("RunAll returned %v, want context.Canceled", err)
+	}
+	if n := atomic.LoadInt32(&ran); n != 1 {
+		t.Errorf("%d functions ran after cancellation, want 1", n)
+	}
+}
+
+func TestRunAllFirstError(t *testing.T) {
+	errFirst := errors.New("first")
+	err := RunAll(context.Background(), 1,
+		func(context.Context) error { return errFirst },
+		func(ctx context.Context) error { return ctx.Err() },
+	)
+	if err != errFirst {
+		t.Errorf("RunAll returned %v, want %v", err, errFirst)
+	}
+}
@@ -0,0 +1,36 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OnceError is like sync.Once, but fn is retried on later calls until it succeeds.
+// Once a call to fn returns nil, Do never calls any function again. The zero value is
+// ready to use, and an OnceError must not be copied after first use.
+type OnceError struct {
+	done atomic.Bool
+	mu   sync.Mutex
+}
+
+// Do calls fn if no previous call has succeeded and returns its error.
+// Concurrent callers block until the in-flight attempt finishes; after a success Do
+// returns nil immediately.
+func (o *OnceError) Do(fn func() error) error {
+	if o.done.Load() {
+		return nil
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.done.Load() {
+		return nil
+	}
+	if err := fn(); err != nil {
+		return err
+	}
+	o.done.Store(true)
+	return nil
+}
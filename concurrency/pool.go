@@ -0,0 +1,77 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Pool runs submitted tasks on a fixed number of worker goroutines
+type Pool struct {
+	tasks chan func() error
+	wg    sync.WaitGroup
+	mu    sync.Mutex
+	errs  []error
+}
+
+// NewPool returns a new Pool with the given number of workers and an unbuffered task queue
+func NewPool(workers int) *Pool {
+	return NewPoolWithQueue(workers, 0)
+}
+
+// NewPoolWithQueue returns a new Pool whose task queue holds up to queueSize pending tasks.
+// Submit blocks while the queue is full and all workers are busy.
+func NewPoolWithQueue(workers, queueSize int) *Pool {
+	if workers <= 0 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	p := &Pool{tasks: make(chan func() error, queueSize)}
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+// Submit queues a task for execution, blocking if the queue is full.
+// Submit must not be called after Wait; doing so panics.
+func (p *Pool) Submit(task func() error) {
+	p.tasks <- task
+}
+
+// Wait stops accepting tasks, waits for all submitted tasks to finish and returns their errors.
+// A Pool cannot be reused after Wait.
+func (p *Pool) Wait() []error {
+	close(p.tasks)
+	p.wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.errs
+}
+
+// worker runs tasks from the queue until it is closed
+func (p *Pool) worker() {
+	defer p.wg.Done()
+	for task := range p.tasks {
+		if err := runTask(task); err != nil {
+			p.mu.Lock()
+			p.errs = append(p.errs, err)
+			p.mu.Unlock()
+		}
+	}
+}
+
+// runTask runs a task, converting a panic into an error
+func runTask(task func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task panicked: %v", r)
+		}
+	}()
+	return task()
+}
@@ -0,0 +1,70 @@
+package concurrency
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPoolLimitsConcurrency(t *testing.T) {
+	const workers = 3
+	p := NewPoolWithQueue(workers, 10)
+
+	var running, maxRunning int32
+	for i := 0; i < 20; i++ {
+		p.Submit(func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+	}
+
+	if errs := p.Wait(); len(errs) != 0 {
+		t.Errorf("Wait returned %v, want no errors", errs)
+	}
+	if m := atomic.LoadInt32(&maxRunning); m > workers {
+		t.Errorf("%d tasks ran at once, want at most %d", m, workers)
+	}
+}
+
+func TestPoolRecoversPanics(t *testing.T) {
+	p := NewPool(1)
+	errTask := errors.New("task failed")
+
+	var ran int32
+	p.Submit(func() error { panic("boom") })
+	p.Submit(func() error { atomic.AddInt32(&ran, 1); return errTask })
+	p.Submit(func() error { atomic.AddInt32(&ran, 1); return nil })
+
+	errs := p.Wait()
+	if n := atomic.LoadInt32(&ran); n != 2 {
+		t.Errorf("%d tasks ran after the panic, want 2", n)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("Wait returned %d errors, want 2: %v", len(errs), errs)
+	}
+	if !strings.Contains(errs[0].Error(), "boom") || errs[1] != errTask {
+		t.Errorf("Wait returned %v, want the panic followed by %v", errs, errTask)
+	}
+}
+
+func TestPoolSubmitAfterWaitPanics(t *testing.T) {
+	p := NewPool(1)
+	p.Wait()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Submit after Wait did not panic")
+		}
+	}()
+	p.Submit(func() error { return nil })
+}
@@ -0,0 +1,49 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounce returns a function that invokes fn once d has passed without further calls.
+// The returned function is safe for concurrent use; fn runs in its own goroutine.
+func Debounce(d time.Duration, fn func()) func() {
+	var (
+		mu    sync.Mutex
+		timer *time.Timer
+	)
+
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(d, fn)
+	}
+}
+
+// Throttle returns a function that invokes fn at most once per d.
+// Calls made within d of the last invocation are dropped. The returned function is safe
+// for concurrent use; fn runs synchronously in the caller's goroutine.
+func Throttle(d time.Duration, fn func()) func() {
+	var (
+		mu   sync.Mutex
+		last time.Time
+	)
+
+	return func() {
+		mu.Lock()
+		now := time.Now()
+		if !last.IsZero() && now.Sub(last) < d {
+			mu.Unlock()
+			return
+		}
+		last = now
+		mu.Unlock()
+
+		fn()
+	}
+}
@@ -0,0 +1,87 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token-bucket rate limiter.
+// Tokens are refilled continuously at the configured rate, and up to one second's worth
+// of tokens may accumulate, allowing short bursts.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that allows ratePerSecond events per second.
+// A ratePerSecond below 1 is treated as 1. The bucket starts full.
+func NewRateLimiter(ratePerSecond int) *RateLimiter {
+	if ratePerSecond < 1 {
+		ratePerSecond = 1
+	}
+	return &RateLimiter{
+		rate:   float64(ratePerSecond),
+		burst:  float64(ratePerSecond),
+		tokens: float64(ratePerSecond),
+		last:   time.Now(),
+	}
+}
+
+// Allow reports whether a token is available now, consuming it if so.
+func (r *RateLimiter) Allow() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refill(time.Now())
+	if r.tokens < 1 {
+		return false
+	}
+	r.tokens--
+	return true
+}
+
+// Wait blocks until a token is available or ctx is done.
+// It returns ctx.Err() if ctx is done before a token could be taken.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		r.mu.Lock()
+		r.refill(time.Now())
+		if r.tokens >= 1 {
+			r.tokens--
+			r.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - r.tokens) / r.rate * float64(time.Second))
+		r.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// refill adds the tokens accumulated since the last refill; the caller must hold r.mu.
+func (r *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(r.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	r.tokens += elapsed * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+}
@@ -0,0 +1,98 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllowExhaustsBurst(t *testing.T) {
+	r := NewRateLimiter(5)
+	for i := 0; i < 5; i++ {
+		if !r.Allow() {
+			t.Fatalf("Allow() = false on call %d, want true within the burst", i+1)
+		}
+	}
+	if r.Allow() {
+		t.Error("Allow() = true after the burst was used up")
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	r := NewRateLimiter(10)
+	for r.Allow() {
+	}
+
+	// Pretend 300ms have passed since the last refill
+	r.mu.Lock()
+	r.last = r.last.Add(-300 * time.Millisecond)
+	r.mu.Unlock()
+
+	allowed := 0
+	for r.Allow() {
+		allowed++
+	}
+	if allowed != 3 {
+		t.Errorf("%d tokens refilled after 300ms at 10/s, want 3", allowed)
+	}
+
+	// Refilling never exceeds the burst
+	r.mu.Lock()
+	r.last = r.last.Add(-time.Hour)
+	r.mu.Unlock()
+	allowed = 0
+	for r.Allow() {
+		allowed++
+	}
+	if allowed != 10 {
+		t.Errorf("%d tokens available after a long idle period, want the burst of 10", allowed)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	r := NewRateLimiter(100)
+	for r.Allow() {
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := r.Wait(ctx); err != nil {
+		t.Errorf("Wait returned %v, want nil once a token is refilled", err)
+	}
+}
+
+func TestRateLimiterWaitCancel(t *testing.T) {
+	r := NewRateLimiter(1)
+	r.Allow()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := r.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait returned %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Wait took %v to notice cancellation", elapsed)
+	}
+}
+
+func TestRateLimiterNonPositiveRate(t *testing.T) {
+	for _, rate := range []int{0, -5} {
+		r := NewRateLimiter(rate)
+		if !r.Allow() {
+			t.Errorf("NewRateLimiter(%d).Allow() = false, want the rate treated as 1", rate)
+		}
+
+		r.mu.Lock()
+		r.last = r.last.Add(-time.Second)
+		r.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		if err := r.Wait(ctx); err != nil {
+			t.Errorf("NewRateLimiter(%d).Wait returned %v, want nil", rate, err)
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,75 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// RetryOptions configures the backoff used by Retry
+type RetryOptions struct {
+	// InitialDelay is the delay before the second attempt
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between attempts (no cap if zero)
+	MaxDelay time.Duration
+	// Multiplier scales the delay after each attempt (defaults to 2 if <= 0)
+	Multiplier float64
+	// Jitter randomizes each delay to between half and all of its computed value
+	Jitter bool
+	// RetryableFunc reports whether an error is worth retrying (all errors are retried if nil)
+	RetryableFunc func(error) bool
+}
+
+// Retry calls fn up to attempts times with exponential backoff until it succeeds.
+// It returns nil on success, the last error once attempts are exhausted or the error is not
+// retryable, or the context error joined with the last error if ctx is done while waiting.
+func Retry(ctx context.Context, attempts int, fn func() error, opts RetryOptions) error {
+	if attempts <= 0 {
+		attempts = 1
+	}
+	multiplier := opts.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	delay := opts.InitialDelay
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(err, lastErr)
+		}
+
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+		if opts.RetryableFunc != nil && !opts.RetryableFunc(lastErr) {
+			return lastErr
+		}
+		if attempt == attempts {
+			break
+		}
+
+		wait := delay
+		if opts.Jitter && wait > 0 {
+			wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(ctx.Err(), lastErr)
+		case <-timer.C:
+		}
+
+		delay = time.Duration(float64(delay) * multiplier)
+		if opts.MaxDelay > 0 && delay > opts.MaxDelay {
+			delay = opts.MaxDelay
+		}
+	}
+
+	return lastErr
+}
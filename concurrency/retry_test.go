@@ -0,0 +1,83 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryStopsOnNonRetryableError(t *testing.T) {
+	errFatal := errors.New("fatal")
+	calls := 0
+
+	err := Retry(context.Background(), 5, func() error {
+		calls++
+		return errFatal
+	}, RetryOptions{
+		InitialDelay:  time.Hour,
+		RetryableFunc: func(err error) bool { return err != errFatal },
+	})
+
+	if err != errFatal {
+		t.Errorf("Retry returned %v, want %v", err, errFatal)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+}
+
+func TestRetryReturnsLastErrorAfterAttempts(t *testing.T) {
+	calls := 0
+	err := Retry(context.Background(), 3, func() error {
+		calls++
+		if calls == 3 {
+			return errors.New("third")
+		}
+		return errors.New("earlier")
+	}, RetryOptions{})
+
+	if err == nil || err.Error() != "third" {
+		t.Errorf("Retry returned %v, want the last error", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+}
+
+func TestRetryCancelDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	errTemp := errors.New("temporary")
+
+	start := time.Now()
+	err := Retry(ctx, 5, func() error { return errTemp }, RetryOptions{InitialDelay: time.Hour})
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Retry took %v after cancellation", elapsed)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) || !errors.Is(err, errTemp) {
+		t.Errorf("Retry returned %v, want the context error joined with the last error", err)
+	}
+}
+
+func TestRetryCapsDelayAtMaxDelay(t *testing.T) {
+	var times []time.Time
+	err := Retry(context.Background(), 5, func() error {
+		times = append(times, time.Now())
+		return errors.New("fail")
+	}, RetryOptions{InitialDelay: 10 * time.Millisecond, MaxDelay: 20 * time.Millisecond, Multiplier: 10})
+	if err == nil {
+		t.Fatal("Retry returned nil, want an error")
+	}
+
+	// Uncapped, the delays would be 10ms, 100ms, 1s and 10s
+	if total := times[len(times)-1].Sub(times[0]); total > 500*time.Millisecond {
+		t.Errorf("retries took %v, want delays capped at MaxDelay", total)
+	}
+	for i := 2; i < len(times); i++ {
+		if gap := times[i].Sub(times[i-1]); gap < 20*time.Millisecond {
+			t.Errorf("delay before attempt %d = %v, want at least MaxDelay", i+1, gap)
+		}
+	}
+}
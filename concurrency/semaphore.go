@@ -2,6 +2,7 @@
 package concurrency
 
 import (
+	"context"
 	"sync"
 )
 
@@ -16,7 +17,7 @@ func NewSemaphore(maxCount int) *Semaphore {
 	return &Semaphore{c: make(chan struct{}, maxCount)}
 }
 
-// Acquire acquires a permit, blocking until it becomes available or ctx is done.
+// Acquire acquires delta permits, blocking until they become available.
 func (s *Semaphore) Acquire(delta int) {
 	s.wg.Add(delta)
 	for i := 0; i < delta; i++ {
@@ -24,6 +25,40 @@ func (s *Semaphore) Acquire(delta int) {
 	}
 }
 
+// AcquireCtx acquires delta permits, blocking until they become available or ctx is done.
+// If ctx is done first, any permits already obtained are released and ctx.Err() is returned.
+func (s *Semaphore) AcquireCtx(ctx context.Context, delta int) error {
+	for i := 0; i < delta; i++ {
+		select {
+		case s.c <- struct{}{}:
+		case <-ctx.Done():
+			for j := 0; j < i; j++ {
+				<-s.c
+			}
+			return ctx.Err()
+		}
+	}
+	s.wg.Add(delta)
+	return nil
+}
+
+// TryAcquire acquires delta permits without blocking and reports whether it succeeded.
+// Either all delta permits are acquired or none are.
+func (s *Semaphore) TryAcquire(delta int) bool {
+	for i := 0; i < delta; i++ {
+		select {
+		case s.c <- struct{}{}:
+		default:
+			for j := 0; j < i; j++ {
+				<-s.c
+			}
+			return false
+		}
+	}
+	s.wg.Add(delta)
+	return true
+}
+
 // Release releases a permit.
 func (s *Semaphore) Release() {
 	<-s.c
@@ -35,11 +70,17 @@ func (s *Semaphore) Wait() {
 	s.wg.Wait()
 }
 
-// AcquireWithFunc gets the semaphore and executes the callback function with arguments
+// AcquireWithFunc gets the semaphore and executes the callback function with arguments in a new goroutine.
+// The permit is acquired before the goroutine starts, so the caller blocks while the semaphore is full.
+// Like Pool and RunAll, f runs through runTask, so a panic in f is recovered (and discarded, since
+// f has no way to report an error) instead of crashing the process, and the permit is still released.
 func (s *Semaphore) AcquireWithFunc(f func(args ...interface{}), args ...interface{}) {
+	s.Acquire(1)
 	go func() {
 		defer s.Release()
-		s.Acquire(1)
-		f(args...)
+		_ = runTask(func() error {
+			f(args...)
+			return nil
+		})
 	}()
 }
@@ -0,0 +1,14 @@
+package concurrency
+
+import "testing"
+
+func TestAcquireWithFuncRecoversPanic(t *testing.T) {
+	s := NewSemaphore(1)
+	s.AcquireWithFunc(func(args ...interface{}) { panic("boom") })
+	s.Wait()
+
+	if !s.TryAcquire(1) {
+		t.Fatal("permit was not released after f panicked")
+	}
+	s.Release()
+}
@@ -0,0 +1,98 @@
+// Package concurrency provides concurrency control utilities
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ShutdownGroup coordinates the graceful shutdown of registered components
+type ShutdownGroup struct {
+	// Concurrent runs the shutdown hooks concurrently instead of in reverse registration order
+	Concurrent bool
+
+	mu    sync.Mutex
+	hooks []func(ctx context.Context) error
+}
+
+// NewShutdownGroup returns a new ShutdownGroup that runs hooks in reverse registration order
+func NewShutdownGroup() *ShutdownGroup {
+	return &ShutdownGroup{}
+}
+
+// OnShutdown registers a hook to run when Shutdown is called
+func (g *ShutdownGroup) OnShutdown(fn func(ctx context.Context) error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.hooks = append(g.hooks, fn)
+}
+
+// Shutdown runs all registered hooks and returns their aggregated errors
+// The hooks receive a context that is cancelled after timeout; hooks still running
+// at that point are abandoned and the context error is included in the result
+func (g *ShutdownGroup) Shutdown(timeout time.Duration) error {
+	g.mu.Lock()
+	hooks := make([]func(ctx context.Context) error, len(g.hooks))
+	copy(hooks, g.hooks)
+	g.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if g.Concurrent {
+		return runHooksConcurrently(ctx, hooks)
+	}
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if err := runHook(ctx, hooks[i]); err != nil {
+			errs = append(errs, err)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// runHooksConcurrently runs all hooks at once and waits for them or for ctx to be done
+func runHooksConcurrently(ctx context.Context, hooks []func(ctx context.Context) error) error {
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+
+	for _, hook := range hooks {
+		wg.Add(1)
+		go func(hook func(ctx context.Context) error) {
+			defer wg.Done()
+			if err := runHook(ctx, hook); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(hook)
+	}
+
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// runHook runs a single hook, returning early with the context error if ctx is done first
+func runHook(ctx context.Context, hook func(ctx context.Context) error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- hook(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown hook did not finish: %w", ctx.Err())
+	}
+}
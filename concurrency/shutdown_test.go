@@ -0,0 +1,70 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestShutdownGroupReverseOrder(t *testing.T) {
+	g := NewShutdownGroup()
+
+	var mu sync.Mutex
+	var order []int
+	for i := 1; i <= 3; i++ {
+		i := i
+		g.OnShutdown(func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, i)
+			return nil
+		})
+	}
+
+	if err := g.Shutdown(time.Second); err != nil {
+		t.Fatalf("Shutdown returned error: %v", err)
+	}
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(order, want) {
+		t.Errorf("hooks ran in order %v, want %v", order, want)
+	}
+}
+
+func TestShutdownGroupAggregatesErrors(t *testing.T) {
+	g := NewShutdownGroup()
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+	g.OnShutdown(func(ctx context.Context) error { return errA })
+	g.OnShutdown(func(ctx context.Context) error { return errB })
+
+	err := g.Shutdown(time.Second)
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Errorf("Shutdown error = %v, want both hook errors", err)
+	}
+}
+
+func TestShutdownGroupTimeout(t *testing.T) {
+	for _, concurrent := range []bool{false, true} {
+		g := NewShutdownGroup()
+		g.Concurrent = concurrent
+		release := make(chan struct{})
+		g.OnShutdown(func(ctx context.Context) error {
+			<-release
+			return nil
+		})
+
+		start := time.Now()
+		err := g.Shutdown(20 * time.Millisecond)
+		elapsed := time.Since(start)
+		close(release)
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("concurrent=%v: Shutdown error = %v, want context.DeadlineExceeded", concurrent, err)
+		}
+		if elapsed > time.Second {
+			t.Errorf("concurrent=%v: Shutdown took %v, want it to return at the timeout", concurrent, elapsed)
+		}
+	}
+}
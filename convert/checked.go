@@ -0,0 +1,116 @@
+// Package convert provides type conversion utilities
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// ToIntChecked converts an interface{} value to int like ToIntE, but returns an error
+// instead of silently overflowing when the value is outside the range of int
+// Fractional parts of floats and float strings are truncated toward zero
+func ToIntChecked(v interface{}) (int, error) {
+	i, err := toIntegerInRange(v, "int", big.NewInt(math.MinInt), big.NewInt(math.MaxInt))
+	return int(i.Int64()), err
+}
+
+// ToInt32Checked converts an interface{} value to int32, returning an error if it is out of range
+// Fractional parts of floats and float strings are truncated toward zero
+func ToInt32Checked(v interface{}) (int32, error) {
+	i, err := toIntegerInRange(v, "int32", big.NewInt(math.MinInt32), big.NewInt(math.MaxInt32))
+	return int32(i.Int64()), err
+}
+
+// ToInt64Checked converts an interface{} value to int64, returning an error if it is out of range
+// Fractional parts of floats and float strings are truncated toward zero
+func ToInt64Checked(v interface{}) (int64, error) {
+	i, err := toIntegerInRange(v, "int64", big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64))
+	return i.Int64(), err
+}
+
+// ToUint64Checked converts an interface{} value to uint64, returning an error if it is negative
+// or too large. Fractional parts of floats and float strings are truncated toward zero
+func ToUint64Checked(v interface{}) (uint64, error) {
+	i, err := toIntegerInRange(v, "uint64", big.NewInt(0), new(big.Int).SetUint64(math.MaxUint64))
+	return i.Uint64(), err
+}
+
+// toIntegerInRange converts v to an integer and checks that it lies within [lo, hi]
+// On error the returned integer is zero
+func toIntegerInRange(v interface{}, target string, lo, hi *big.Int) (*big.Int, error) {
+	i, err := toBigInt(v, target)
+	if err != nil {
+		return new(big.Int), err
+	}
+	if i.Cmp(lo) < 0 || i.Cmp(hi) > 0 {
+		return new(big.Int), fmt.Errorf("value %v overflows %s", v, target)
+	}
+	return i, nil
+}
+
+// toBigInt converts v to an arbitrary-precision integer without losing range
+func toBigInt(v interface{}, target string) (*big.Int, error) {
+	v, err := applyConverter(v)
+	if err != nil {
+		return nil, err
+	}
+
+	switch val := v.(type) {
+	case int:
+		return big.NewInt(int64(val)), nil
+	case int8:
+		return big.NewInt(int64(val)), nil
+	case int16:
+		return big.NewInt(int64(val)), nil
+	case int32:
+		return big.NewInt(int64(val)), nil
+	case int64:
+		return big.NewInt(val), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(val)), nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(val)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(val)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(val)), nil
+	case uint64:
+		return new(big.Int).SetUint64(val), nil
+	case float32:
+		return floatToBigInt(float64(val), target)
+	case float64:
+		return floatToBigInt(val, target)
+	case string:
+		return stringToBigInt(val, target)
+	case json.Number:
+		return stringToBigInt(string(val), target)
+	case nil:
+		return new(big.Int), nil
+	default:
+		return nil, fmt.Errorf("unable to convert %#v of type %T to %s", v, v, target)
+	}
+}
+
+// floatToBigInt truncates a float toward zero, rejecting NaN and infinities
+func floatToBigInt(f float64, target string) (*big.Int, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("unable to convert %v to %s", f, target)
+	}
+	i, _ := big.NewFloat(f).Int(nil)
+	return i, nil
+}
+
+// stringToBigInt parses an integer or float string
+func stringToBigInt(s, target string) (*big.Int, error) {
+	if i, ok := new(big.Int).SetString(s, 10); ok {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert %q to %s: %w", s, target, err)
+	}
+	return floatToBigInt(f, target)
+}
@@ -3,14 +3,20 @@ package convert
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/cx-luo/go-toolkit/internal/boolstr"
+	"github.com/cx-luo/go-toolkit/timeutil"
 )
 
 // ToString converts an interface{} value to string
 func ToString(value interface{}) string {
 	var key string
+	value, _ = applyConverter(value)
 	if value == nil {
 		return key
 	}
@@ -70,144 +76,237 @@ func ToString(value interface{}) string {
 	return key
 }
 
+// ToStringWithFormat converts an interface{} value to string, formatting time.Time values with timeFormat
+// An empty timeFormat defaults to timeutil.FormatDateTime; other values are handled like ToString
+func ToStringWithFormat(v interface{}, timeFormat string) string {
+	if timeFormat == "" {
+		timeFormat = timeutil.FormatDateTime
+	}
+
+	v, _ = applyConverter(v)
+	switch t := v.(type) {
+	case time.Time:
+		return t.Format(timeFormat)
+	case *time.Time:
+		if t != nil {
+			return t.Format(timeFormat)
+		}
+	}
+	return ToString(v)
+}
+
 // ToInt converts an interface{} value to int
 func ToInt(v interface{}) int {
-	var r int
-	switch v.(type) {
+	r, _ := ToIntE(v)
+	return r
+}
+
+// ToIntE converts an interface{} value to int, returning an error if the conversion fails
+// Float strings (e.g. "3.7") are truncated toward zero, as in ToInt64E
+func ToIntE(v interface{}) (int, error) {
+	v, err := applyConverter(v)
+	if err != nil {
+		return 0, err
+	}
+
+	switch val := v.(type) {
+	case int:
+		return val, nil
 	case uint:
-		r = int(v.(uint))
+		return int(val), nil
 	case int8:
-		r = int(v.(int8))
+		return int(val), nil
 	case uint8:
-		r = int(v.(uint8))
+		return int(val), nil
 	case int16:
-		r = int(v.(int16))
+		return int(val), nil
 	case uint16:
-		r = int(v.(uint16))
+		return int(val), nil
 	case int32:
-		r = int(v.(int32))
+		return int(val), nil
 	case uint32:
-		r = int(v.(uint32))
+		return int(val), nil
 	case int64:
-		r = int(v.(int64))
+		return int(val), nil
 	case uint64:
-		r = int(v.(uint64))
+		return int(val), nil
 	case float32:
-		r = int(v.(float32))
+		return int(val), nil
 	case float64:
-		r = int(v.(float64))
+		return int(val), nil
 	case string:
-		r, _ = strconv.Atoi(v.(string))
-		if r == 0 && len(v.(string)) > 0 {
-			f, _ := strconv.ParseFloat(v.(string), 64)
-			r = int(f)
-		}
+		i, err := parseIntString(val, "int")
+		return int(i), err
 	case nil:
-		r = 0
+		return 0, nil
 	case json.Number:
-		t3, _ := v.(json.Number).Int64()
-		r = int(t3)
+		i, err := parseIntString(string(val), "int")
+		return int(i), err
 	default:
-		r = v.(int)
+		return 0, fmt.Errorf("unable to convert %#v of type %T to int", v, v)
 	}
-	return r
+}
+
+// parseIntString parses s as an integer for ToIntE and ToInt64E
+// Float strings such as "3.7" are accepted and truncated toward zero; values outside the int64 range fail
+func parseIntString(s string, target string) (int64, error) {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return i, nil
+	}
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil {
+		return 0, fmt.Errorf("unable to convert %q to %s: %w", s, target, err)
+	}
+	if math.IsNaN(f) || f >= math.MaxInt64 || f < math.MinInt64 {
+		return 0, fmt.Errorf("unable to convert %q to %s: value out of range", s, target)
+	}
+	return int64(f), nil
 }
 
 // ToInt64 converts an interface{} value to int64
 func ToInt64(v interface{}) int64 {
+	r, _ := ToInt64E(v)
+	return r
+}
+
+// ToInt64E converts an interface{} value to int64, returning an error if the conversion fails
+// Float strings (e.g. "3.7") are truncated toward zero, as in ToIntE
+func ToInt64E(v interface{}) (int64, error) {
+	v, err := applyConverter(v)
+	if err != nil {
+		return 0, err
+	}
+
 	switch val := v.(type) {
 	case int:
-		return int64(val)
+		return int64(val), nil
 	case int8:
-		return int64(val)
+		return int64(val), nil
 	case int16:
-		return int64(val)
+		return int64(val), nil
 	case int32:
-		return int64(val)
+		return int64(val), nil
 	case int64:
-		return val
+		return val, nil
 	case uint:
-		return int64(val)
+		return int64(val), nil
 	case uint8:
-		return int64(val)
+		return int64(val), nil
 	case uint16:
-		return int64(val)
+		return int64(val), nil
 	case uint32:
-		return int64(val)
+		return int64(val), nil
 	case uint64:
-		return int64(val)
+		return int64(val), nil
 	case float32:
-		return int64(val)
+		return int64(val), nil
 	case float64:
-		return int64(val)
+		return int64(val), nil
 	case string:
-		i, _ := strconv.ParseInt(val, 10, 64)
-		return i
+		return parseIntString(val, "int64")
 	case json.Number:
-		i, _ := val.Int64()
-		return i
+		return parseIntString(string(val), "int64")
+	case nil:
+		return 0, nil
 	default:
-		return 0
+		return 0, fmt.Errorf("unable to convert %#v of type %T to int64", v, v)
 	}
 }
 
 // ToFloat64 converts an interface{} value to float64
 func ToFloat64(v interface{}) float64 {
+	r, _ := ToFloat64E(v)
+	return r
+}
+
+// ToFloat64E converts an interface{} value to float64, returning an error if the conversion fails
+func ToFloat64E(v interface{}) (float64, error) {
+	v, err := applyConverter(v)
+	if err != nil {
+		return 0, err
+	}
+
 	switch val := v.(type) {
 	case float32:
-		return float64(val)
+		return float64(val), nil
 	case float64:
-		return val
+		return val, nil
 	case int:
-		return float64(val)
+		return float64(val), nil
 	case int8:
-		return float64(val)
+		return float64(val), nil
 	case int16:
-		return float64(val)
+		return float64(val), nil
 	case int32:
-		return float64(val)
+		return float64(val), nil
 	case int64:
-		return float64(val)
+		return float64(val), nil
 	case uint:
-		return float64(val)
+		return float64(val), nil
 	case uint8:
-		return float64(val)
+		return float64(val), nil
 	case uint16:
-		return float64(val)
+		return float64(val), nil
 	case uint32:
-		return float64(val)
+		return float64(val), nil
 	case uint64:
-		return float64(val)
+		return float64(val), nil
 	case string:
-		f, _ := strconv.ParseFloat(val, 64)
-		return f
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert %q to float64: %w", val, err)
+		}
+		return f, nil
 	case json.Number:
-		f, _ := val.Float64()
-		return f
+		f, err := val.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert %q to float64: %w", val, err)
+		}
+		return f, nil
+	case nil:
+		return 0, nil
 	default:
-		return 0
+		return 0, fmt.Errorf("unable to convert %#v of type %T to float64", v, v)
 	}
 }
 
 // ToBool converts an interface{} value to bool
+// Strings such as "true"/"false", "yes"/"no", "on"/"off", "y"/"n" and "1"/"0" are recognized case-insensitively
 func ToBool(v interface{}) bool {
+	r, _ := ToBoolE(v)
+	return r
+}
+
+// ToBoolE converts an interface{} value to bool, returning an error if the conversion fails
+func ToBoolE(v interface{}) (bool, error) {
+	v, err := applyConverter(v)
+	if err != nil {
+		return false, err
+	}
+
 	switch val := v.(type) {
 	case bool:
-		return val
+		return val, nil
 	case string:
-		b, _ := strconv.ParseBool(val)
-		return b
+		b, ok := boolstr.Parse(val)
+		if !ok {
+			return false, fmt.Errorf("unable to convert %q to bool", val)
+		}
+		return b, nil
 	case int:
-		return val != 0
+		return val != 0, nil
 	case int8:
-		return val != 0
+		return val != 0, nil
 	case int16:
-		return val != 0
+		return val != 0, nil
 	case int32:
-		return val != 0
+		return val != 0, nil
 	case int64:
-		return val != 0
+		return val != 0, nil
+	case nil:
+		return false, nil
 	default:
-		return false
+		return false, fmt.Errorf("unable to convert %#v of type %T to bool", v, v)
 	}
 }
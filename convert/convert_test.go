@@ -0,0 +1,39 @@
+package convert
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestToIntEAndToInt64EAgree(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		want    int64
+		wantErr bool
+	}{
+		{"42", 42, false},
+		{"-7", -7, false},
+		{"3.7", 3, false},
+		{"-3.7", -3, false},
+		{"1e3", 1000, false},
+		{json.Number("3.7"), 3, false},
+		{json.Number("12"), 12, false},
+		{"", 0, true},
+		{"abc", 0, true},
+		{"NaN", 0, true},
+		{"1e30", 0, true},
+		{2.9, 2, false},
+		{nil, 0, false},
+	}
+
+	for _, tt := range tests {
+		i, err := ToIntE(tt.input)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && int64(i) != tt.want) {
+			t.Errorf("ToIntE(%#v) = %d, %v; want %d, error %v", tt.input, i, err, tt.want, tt.wantErr)
+		}
+		i64, err := ToInt64E(tt.input)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && i64 != tt.want) {
+			t.Errorf("ToInt64E(%#v) = %d, %v; want %d, error %v", tt.input, i64, err, tt.want, tt.wantErr)
+		}
+	}
+}
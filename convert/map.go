@@ -0,0 +1,49 @@
+// Package convert provides type conversion utilities
+package convert
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ToStringMap converts a map with arbitrary keys (e.g. map[interface{}]interface{} from YAML)
+// to map[string]interface{}. Keys are converted with ToString, and nested maps and slices
+// are normalized recursively. An error is returned for non-map input
+func ToStringMap(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Map {
+		return nil, fmt.Errorf("unable to convert %T to map[string]interface{}: not a map", v)
+	}
+	if rv.IsNil() {
+		return nil, nil
+	}
+
+	result := make(map[string]interface{}, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		result[ToString(iter.Key().Interface())] = normalizeValue(iter.Value().Interface())
+	}
+	return result, nil
+}
+
+// normalizeValue recursively converts nested maps to map[string]interface{}
+// and nested slices to []interface{}
+func normalizeValue(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		m, _ := ToStringMap(v)
+		return m
+	case reflect.Slice:
+		if _, isBytes := v.([]byte); isBytes || rv.IsNil() {
+			return v
+		}
+		result := make([]interface{}, rv.Len())
+		for i := range result {
+			result[i] = normalizeValue(rv.Index(i).Interface())
+		}
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,15 @@
+// Package convert provides type conversion utilities
+package convert
+
+// Ptr returns a pointer to a copy of v
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref returns the value p points to, or fallback if p is nil
+func Deref[T any](p *T, fallback T) T {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
@@ -0,0 +1,51 @@
+// Package convert provides type conversion utilities
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ConverterFunc converts a value of a custom type into a value the default conversions understand
+type ConverterFunc func(interface{}) (interface{}, error)
+
+var (
+	convertersMu sync.RWMutex
+	converters   = make(map[reflect.Type]ConverterFunc)
+)
+
+// RegisterConverter registers a converter for values of type t
+// ToString, ToInt, ToInt64, ToFloat64 and ToBool apply the converter first and then
+// convert its result as usual. Registering a nil fn removes the converter for t
+func RegisterConverter(t reflect.Type, fn ConverterFunc) {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+
+	if fn == nil {
+		delete(converters, t)
+		return
+	}
+	converters[t] = fn
+}
+
+// applyConverter runs the registered converter for the value's type, if any
+// The value is returned unchanged if no converter is registered for its type
+func applyConverter(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	convertersMu.RLock()
+	fn, ok := converters[reflect.TypeOf(value)]
+	convertersMu.RUnlock()
+	if !ok {
+		return value, nil
+	}
+
+	converted, err := fn(value)
+	if err != nil {
+		return value, fmt.Errorf("custom converter for %T failed: %w", value, err)
+	}
+	return converted, nil
+}
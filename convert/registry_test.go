@@ -0,0 +1,49 @@
+package convert
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type cents int64
+
+func TestRegisterConverter(t *testing.T) {
+	typ := reflect.TypeOf(cents(0))
+	RegisterConverter(typ, func(v interface{}) (interface{}, error) {
+		return float64(v.(cents)) / 100, nil
+	})
+	t.Cleanup(func() { RegisterConverter(typ, nil) })
+
+	if got := ToString(cents(1250)); got != "12.5" {
+		t.Errorf("ToString(cents(1250)) = %q, want %q", got, "12.5")
+	}
+	if got := ToFloat64(cents(1250)); got != 12.5 {
+		t.Errorf("ToFloat64(cents(1250)) = %v, want 12.5", got)
+	}
+}
+
+func TestRegisterConverterError(t *testing.T) {
+	typ := reflect.TypeOf(cents(0))
+	errBad := errors.New("bad value")
+	RegisterConverter(typ, func(v interface{}) (interface{}, error) {
+		return nil, errBad
+	})
+	t.Cleanup(func() { RegisterConverter(typ, nil) })
+
+	if _, err := ToIntE(cents(1)); !errors.Is(err, errBad) {
+		t.Errorf("ToIntE error = %v, want it to wrap %v", err, errBad)
+	}
+}
+
+func TestRegisterConverterRemove(t *testing.T) {
+	typ := reflect.TypeOf(cents(0))
+	RegisterConverter(typ, func(v interface{}) (interface{}, error) {
+		return "converted", nil
+	})
+	RegisterConverter(typ, nil)
+
+	if _, err := ToIntE(cents(5)); err == nil {
+		t.Error("ToIntE(cents(5)) succeeded after the converter was removed")
+	}
+}
@@ -0,0 +1,67 @@
+// Package convert provides type conversion utilities
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ToSlice converts a slice (typically []interface{} from JSON) to a slice of T
+// Elements are converted with ToString, ToIntE, ToInt64E, ToFloat64E, ToBoolE or ToTime
+// depending on T; other element types must already be assignable to T
+func ToSlice[T any](v interface{}) ([]T, error) {
+	if typed, ok := v.([]T); ok {
+		return typed, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("unable to convert %T to slice: not a slice", v)
+	}
+
+	result := make([]T, rv.Len())
+	for i := range result {
+		elem, err := convertElem[T](rv.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		result[i] = elem
+	}
+	return result, nil
+}
+
+// convertElem converts a single value to T
+func convertElem[T any](v interface{}) (T, error) {
+	var zero T
+	var (
+		converted interface{}
+		err       error
+	)
+
+	switch any(zero).(type) {
+	case string:
+		converted = ToString(v)
+	case int:
+		converted, err = ToIntE(v)
+	case int64:
+		converted, err = ToInt64E(v)
+	case float64:
+		converted, err = ToFloat64E(v)
+	case bool:
+		converted, err = ToBoolE(v)
+	case time.Time:
+		converted, err = ToTime(v)
+	default:
+		typed, ok := v.(T)
+		if !ok {
+			return zero, fmt.Errorf("unable to convert %#v of type %T to %T", v, v, zero)
+		}
+		return typed, nil
+	}
+
+	if err != nil {
+		return zero, err
+	}
+	return converted.(T), nil
+}
@@ -0,0 +1,53 @@
+// Package convert provides type conversion utilities
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// StructToMap converts a struct (or pointer to struct) to a map using its json tags
+// Tag options such as omitempty and embedded structs follow encoding/json rules,
+// so nested structs become maps and numbers become float64
+func StructToMap(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("unable to convert nil pointer to map")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unable to convert %T to map: not a struct", v)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal struct: %w", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal struct: %w", err)
+	}
+	return result, nil
+}
+
+// MapToStruct fills the struct pointed to by out from a map using its json tags
+func MapToStruct(m map[string]interface{}, out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("out must be a non-nil pointer, got %T", out)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to marshal map: %w", err)
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal into %T: %w", out, err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Package convert provides type conversion utilities
+package convert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/cx-luo/go-toolkit/timeutil"
+)
+
+// timeLayouts lists the layouts tried, in order, when converting a string to time.Time
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	timeutil.FormatISO8601,
+	timeutil.FormatDateTime,
+	timeutil.FormatDateTimeT,
+	timeutil.FormatDate,
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// ToTime converts an interface{} value to time.Time
+// Integers are treated as Unix seconds, floats as Unix seconds with a fractional part,
+// and strings are parsed with the common timeutil formats (in UTC when no zone is given)
+func ToTime(v interface{}) (time.Time, error) {
+	v, err := applyConverter(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch val := v.(type) {
+	case time.Time:
+		return val, nil
+	case *time.Time:
+		if val == nil {
+			return time.Time{}, fmt.Errorf("unable to convert nil *time.Time to time.Time")
+		}
+		return *val, nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		sec, err := ToInt64E(val)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(sec, 0), nil
+	case float32:
+		return floatToTime(float64(val)), nil
+	case float64:
+		return floatToTime(val), nil
+	case json.Number:
+		f, err := val.Float64()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to convert %q to time.Time: %w", val, err)
+		}
+		return floatToTime(f), nil
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, val); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("unable to parse %q as time.Time", val)
+	default:
+		return time.Time{}, fmt.Errorf("unable to convert %#v of type %T to time.Time", v, v)
+	}
+}
+
+// floatToTime converts Unix seconds with a fractional part to time.Time
+func floatToTime(f float64) time.Time {
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*1e9))
+}
+
+// ToDuration converts an interface{} value to time.Duration
+// Strings are parsed with time.ParseDuration (e.g. "30s", "5m"); bare numbers, including numeric
+// strings such as "30", are treated as seconds, with floats allowing fractional seconds.
+// NaN, infinities and values outside the range of time.Duration return an error
+func ToDuration(v interface{}) (time.Duration, error) {
+	v, err := applyConverter(v)
+	if err != nil {
+		return 0, err
+	}
+
+	switch val := v.(type) {
+	case time.Duration:
+		return val, nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		sec, err := ToInt64Checked(val)
+		if err != nil || sec > maxDurationSeconds || sec < -maxDurationSeconds {
+			return 0, fmt.Errorf("unable to convert %v to time.Duration: out of range", val)
+		}
+		return time.Duration(sec) * time.Second, nil
+	case float32:
+		return secondsToDuration(float64(val))
+	case float64:
+		return secondsToDuration(val)
+	case json.Number:
+		f, err := val.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert %q to time.Duration: %w", val, err)
+		}
+		return secondsToDuration(f)
+	case string:
+		f, err := strconv.ParseFloat(val, 64)
+		if err == nil {
+			return secondsToDuration(f)
+		}
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, fmt.Errorf("unable to convert %q to time.Duration: %w", val, err)
+		}
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert %q to time.Duration: %w", val, err)
+		}
+		return d, nil
+	default:
+		return 0, fmt.Errorf("unable to convert %#v of type %T to time.Duration", v, v)
+	}
+}
+
+// maxDurationSeconds is the largest whole number of seconds a time.Duration can hold
+const maxDurationSeconds = math.MaxInt64 / int64(time.Second)
+
+// secondsToDuration converts seconds with a fractional part to time.Duration
+// Non-finite values and values outside the range of time.Duration return an error
+func secondsToDuration(sec float64) (time.Duration, error) {
+	if math.IsNaN(sec) || math.IsInf(sec, 0) {
+		return 0, fmt.Errorf("unable to convert %v to time.Duration: not a finite number", sec)
+	}
+	ns := sec * float64(time.Second)
+	if ns >= math.MaxInt64 || ns < math.MinInt64 {
+		return 0, fmt.Errorf("unable to convert %v to time.Duration: out of range", sec)
+	}
+	return time.Duration(ns), nil
+}
@@ -0,0 +1,26 @@
+// Package crypto provides cryptographic utilities
+package crypto
+
+import (
+	"fmt"
+	"hash/crc32"
+	"hash/crc64"
+)
+
+// crc64Table is the ISO polynomial table used by CRC64
+var crc64Table = crc64.MakeTable(crc64.ISO)
+
+// CRC32 returns the CRC-32 (IEEE) checksum of data
+func CRC32(data []byte) uint32 {
+	return crc32.ChecksumIEEE(data)
+}
+
+// CRC32Hex returns the CRC-32 (IEEE) checksum of data as an 8-character hex string
+func CRC32Hex(data []byte) string {
+	return fmt.Sprintf("%08x", CRC32(data))
+}
+
+// CRC64 returns the CRC-64 (ISO) checksum of data
+func CRC64(data []byte) uint64 {
+	return crc64.Checksum(data, crc64Table)
+}
@@ -0,0 +1,17 @@
+// Package crypto provides cryptographic utilities
+package crypto
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+// SecureCompare reports whether a and b are equal, in time independent of their contents
+// Use it for secrets such as tokens, API keys and MACs, where == would leak timing information;
+// it is slower than == and not meant for general string equality. Both inputs are hashed
+// with SHA-256 first, so the comparison does not leak their lengths either
+func SecureCompare(a, b string) bool {
+	hashA := sha256.Sum256([]byte(a))
+	hashB := sha256.Sum256([]byte(b))
+	return subtle.ConstantTimeCompare(hashA[:], hashB[:]) == 1
+}
@@ -8,6 +8,11 @@ import (
 	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"io"
+	"strings"
 )
 
 // MD5 returns the MD5 hash of a string
@@ -59,17 +64,70 @@ func SHA512Bytes(data []byte) string {
 }
 
 // HashString returns a hash string using the specified algorithm
+// Supported algorithms are "md5", "sha1", "sha256", "sha512", "crc32" and "crc64"
 func HashString(text string, algorithm string) (string, error) {
+	return HashReader(strings.NewReader(text), algorithm)
+}
+
+// HashReader streams r through the specified hash algorithm and returns the hex digest
+func HashReader(r io.Reader, algorithm string) (string, error) {
+	newHash, err := hashFunc(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	h := newHash()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashFunc returns the hash constructor for the specified algorithm
+func hashFunc(algorithm string) (func() hash.Hash, error) {
 	switch algorithm {
 	case "md5":
-		return MD5(text), nil
+		return md5.New, nil
 	case "sha1":
-		return SHA1(text), nil
+		return sha1.New, nil
 	case "sha256":
-		return SHA256(text), nil
+		return sha256.New, nil
 	case "sha512":
-		return SHA512(text), nil
+		return sha512.New, nil
+	case "crc32":
+		return func() hash.Hash { return crc32.NewIEEE() }, nil
+	case "crc64":
+		return func() hash.Hash { return crc64.New(crc64Table) }, nil
 	default:
-		return "", fmt.Errorf("unsupported algorithm: %s", algorithm)
+		return nil, fmt.Errorf("unsupported algorithm: %s", algorithm)
+	}
+}
+
+// MultiHash reads r once and returns the hex digest for each requested algorithm
+// The result maps algorithm names (as accepted by HashString) to digests; duplicates are hashed once
+func MultiHash(r io.Reader, algorithms ...string) (map[string]string, error) {
+	hashes := make(map[string]hash.Hash, len(algorithms))
+	writers := make([]io.Writer, 0, len(algorithms))
+	for _, algorithm := range algorithms {
+		if _, exists := hashes[algorithm]; exists {
+			continue
+		}
+		newHash, err := hashFunc(algorithm)
+		if err != nil {
+			return nil, err
+		}
+		h := newHash()
+		hashes[algorithm] = h
+		writers = append(writers, h)
+	}
+
+	if _, err := io.Copy(io.MultiWriter(writers...), r); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(hashes))
+	for algorithm, h := range hashes {
+		result[algorithm] = hex.EncodeToString(h.Sum(nil))
 	}
+	return result, nil
 }
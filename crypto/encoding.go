@@ -0,0 +1,27 @@
+// Package crypto provides cryptographic utilities
+package crypto
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// Base64Encode encodes data using standard base64 with padding
+func Base64Encode(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// Base64Decode decodes a standard base64 string with padding
+func Base64Decode(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(s)
+}
+
+// Base64URLEncode encodes data using URL-safe base64 without padding
+func Base64URLEncode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Base64URLDecode decodes a URL-safe base64 string, with or without padding
+func Base64URLDecode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
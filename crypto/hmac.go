@@ -0,0 +1,70 @@
+// Package crypto provides cryptographic utilities
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+)
+
+// HMACSHA1 returns the hex-encoded HMAC-SHA1 of message using key
+func HMACSHA1(message, key []byte) string {
+	return hmacHex(sha1.New, message, key)
+}
+
+// HMACSHA256 returns the hex-encoded HMAC-SHA256 of message using key
+func HMACSHA256(message, key []byte) string {
+	return hmacHex(sha256.New, message, key)
+}
+
+// HMACSHA512 returns the hex-encoded HMAC-SHA512 of message using key
+func HMACSHA512(message, key []byte) string {
+	return hmacHex(sha512.New, message, key)
+}
+
+// VerifyHMAC checks in constant time whether expectedHex is the HMAC of message using key
+// Supported algorithms are "md5", "sha1", "sha256" and "sha512"; any other algorithm returns false
+func VerifyHMAC(message, key []byte, expectedHex, algorithm string) bool {
+	newHash, err := hmacHashFunc(algorithm)
+	if err != nil {
+		return false
+	}
+	expected, err := hex.DecodeString(expectedHex)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(newHash, key)
+	mac.Write(message)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// hmacHex computes an HMAC with the given hash and returns it hex-encoded
+func hmacHex(newHash func() hash.Hash, message, key []byte) string {
+	mac := hmac.New(newHash, key)
+	mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// hmacHashFunc returns the hash constructor for an HMAC algorithm
+// Unlike hashFunc, non-cryptographic checksums such as CRC are rejected, since an HMAC built on
+// them is trivially forgeable
+func hmacHashFunc(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case "md5":
+		return md5.New, nil
+	case "sha1":
+		return sha1.New, nil
+	case "sha256":
+		return sha256.New, nil
+	case "sha512":
+		return sha512.New, nil
+	default:
+		return nil, fmt.Errorf("unsupported HMAC algorithm: %s", algorithm)
+	}
+}
@@ -0,0 +1,30 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"testing"
+)
+
+func TestVerifyHMAC(t *testing.T) {
+	message := []byte("message")
+	key := []byte("key")
+
+	if !VerifyHMAC(message, key, HMACSHA256(message, key), "sha256") {
+		t.Error("VerifyHMAC rejected a valid sha256 HMAC")
+	}
+	if VerifyHMAC(message, key, HMACSHA256(message, key), "sha512") {
+		t.Error("VerifyHMAC accepted a sha256 HMAC as sha512")
+	}
+
+	mac := hmac.New(func() hash.Hash { return crc32.NewIEEE() }, key)
+	mac.Write(message)
+	crcMAC := hex.EncodeToString(mac.Sum(nil))
+	for _, algorithm := range []string{"crc32", "crc64", "unknown"} {
+		if VerifyHMAC(message, key, crcMAC, algorithm) {
+			t.Errorf("VerifyHMAC accepted algorithm %q", algorithm)
+		}
+	}
+}
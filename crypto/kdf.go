@@ -0,0 +1,42 @@
+// Package crypto provides cryptographic utilities
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Recommended scrypt parameters for interactive use (see the scrypt package documentation)
+const (
+	DefaultScryptN = 32768
+	DefaultScryptR = 8
+	DefaultScryptP = 1
+)
+
+// DeriveKeyPBKDF2 derives a key of keyLen bytes from a password using PBKDF2 with HMAC-SHA256
+// Use a random salt (see GenerateSalt) and as many iterations as is acceptable (at least 600000 for SHA256)
+func DeriveKeyPBKDF2(password, salt []byte, iterations, keyLen int) []byte {
+	return pbkdf2.Key(password, salt, iterations, keyLen, sha256.New)
+}
+
+// DeriveKeyScrypt derives a key of keyLen bytes from a password using scrypt
+// N must be a power of two greater than 1; DefaultScryptN, DefaultScryptR and DefaultScryptP are good defaults
+func DeriveKeyScrypt(password, salt []byte, N, r, p, keyLen int) ([]byte, error) {
+	return scrypt.Key(password, salt, N, r, p, keyLen)
+}
+
+// GenerateSalt returns n cryptographically secure random bytes, e.g. for use as a KDF salt
+func GenerateSalt(n int) ([]byte, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("salt length must be positive, got %d", n)
+	}
+	salt := make([]byte, n)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
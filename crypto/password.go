@@ -0,0 +1,28 @@
+// Package crypto provides cryptographic utilities
+package crypto
+
+import (
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DefaultPasswordCost is the bcrypt cost used by HashPassword
+const DefaultPasswordCost = 10
+
+// HashPassword hashes a password with bcrypt using DefaultPasswordCost
+func HashPassword(password string) (string, error) {
+	return HashPasswordWithCost(password, DefaultPasswordCost)
+}
+
+// HashPasswordWithCost hashes a password with bcrypt using the given cost
+func HashPasswordWithCost(password string, cost int) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// VerifyPassword checks a password against a bcrypt hash in constant time
+func VerifyPassword(password, hash string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
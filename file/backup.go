@@ -0,0 +1,82 @@
+// Package file provides file operation utilities
+package file
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// BackupOptions configures WriteFileWithBackupOptions
+type BackupOptions struct {
+	// Timestamped names the backup "<file>.<YYYYMMDDHHMMSS.nnnnnnnnn>.bak" instead of "<file>.bak",
+	// so earlier backups are kept rather than replaced. If that name is already taken, a "-1", "-2",
+	// etc. suffix is added before ".bak"
+	Timestamped bool
+}
+
+// rename is os.Rename, replaced in tests to simulate failures
+var rename = os.Rename
+
+// WriteFileWithBackup writes data to a file, first renaming any existing file to filePath + ".bak"
+// If writing the new content fails, the backup is restored
+func WriteFileWithBackup(filePath string, data []byte) error {
+	return WriteFileWithBackupOptions(filePath, data, BackupOptions{})
+}
+
+// WriteFileWithBackupOptions is like WriteFileWithBackup but allows the backup name to be configured
+// The new content is written to a temporary file first and renamed into place, so a crash never leaves
+// a truncated file. The new file keeps the previous file's permissions (0644 if it had none); new files
+// are created with mode 0644
+func WriteFileWithBackupOptions(filePath string, data []byte, opts BackupOptions) error {
+	info, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return OverwriteFile(filePath, data)
+	}
+	if err != nil {
+		return err
+	}
+
+	tmpPath, err := writeTempFile(filePath, data, info)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	backupPath := filePath + ".bak"
+	if opts.Timestamped {
+		backupPath, err = timestampedBackupPath(filePath, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+	if err := rename(filePath, backupPath); err != nil {
+		return err
+	}
+
+	if err := rename(tmpPath, filePath); err != nil {
+		if restoreErr := rename(backupPath, filePath); restoreErr != nil {
+			return errors.Join(err, restoreErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// timestampedBackupPath returns a backup path for filePath based on t that does not exist yet
+func timestampedBackupPath(filePath string, t time.Time) (string, error) {
+	base := filePath + "." + t.Format("20060102150405.000000000")
+	backupPath := base + ".bak"
+	for i := 1; ; i++ {
+		_, err := os.Lstat(backupPath)
+		if os.IsNotExist(err) {
+			return backupPath, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		backupPath = fmt.Sprintf("%s-%d.bak", base, i)
+	}
+}
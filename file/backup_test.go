@@ -0,0 +1,117 @@
+package file
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteFileWithBackup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.txt")
+	if err := os.WriteFile(path, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteFileWithBackup(path, []byte("new")); err != nil {
+		t.Fatalf("WriteFileWithBackup returned error: %v", err)
+	}
+
+	assertContent(t, path, "new")
+	assertContent(t, path+".bak", "old")
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("mode after write = %o, want 600", perm)
+	}
+}
+
+func TestWriteFileWithBackupRestoresOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.txt")
+	if err := os.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	errRename := errors.New("rename failed")
+	defer func() { rename = os.Rename }()
+	rename = func(oldpath, newpath string) error {
+		if strings.Contains(filepath.Base(oldpath), ".tmp") {
+			return errRename
+		}
+		return os.Rename(oldpath, newpath)
+	}
+
+	if err := WriteFileWithBackup(path, []byte("new")); !errors.Is(err, errRename) {
+		t.Fatalf("WriteFileWithBackup returned %v, want %v", err, errRename)
+	}
+
+	assertContent(t, path, "old")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory holds %d entries after failed write, want only the restored file", len(entries))
+	}
+}
+
+func TestTimestampedBackupPathAvoidsCollisions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.txt")
+	now := time.Now()
+
+	seen := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		backupPath, err := timestampedBackupPath(path, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[backupPath] {
+			t.Fatalf("backup path %s returned twice", backupPath)
+		}
+		seen[backupPath] = true
+		if err := os.WriteFile(backupPath, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestWriteFileWithBackupTimestampedKeepsEveryBackup(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.txt")
+	if err := os.WriteFile(path, []byte("v0"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := BackupOptions{Timestamped: true}
+	for _, v := range []string{"v1", "v2", "v3"} {
+		if err := WriteFileWithBackupOptions(path, []byte(v), opts); err != nil {
+			t.Fatalf("WriteFileWithBackupOptions returned error: %v", err)
+		}
+	}
+
+	backups, err := filepath.Glob(path + ".*.bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 3 {
+		t.Errorf("found %d backups, want 3: %v", len(backups), backups)
+	}
+	assertContent(t, path, "v3")
+}
+
+// assertContent fails the test if the file at path does not contain want
+func assertContent(t *testing.T, path, want string) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != want {
+		t.Errorf("content of %s = %q, want %q", filepath.Base(path), data, want)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+// Package file provides file operation utilities
+package file
+
+import "os"
+
+// chownLike is a no-op on platforms without Unix file ownership
+func chownLike(path string, info os.FileInfo) error {
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:build unix
+
+// Package file provides file operation utilities
+package file
+
+import (
+	"os"
+	"syscall"
+)
+
+// chownLike changes the owner of path to match the owner recorded in info
+func chownLike(path string, info os.FileInfo) error {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	return os.Chown(path, int(stat.Uid), int(stat.Gid))
+}
@@ -4,6 +4,8 @@ package file
 import (
 	"bufio"
 	"io"
+	"mime"
+	"net/http"
 	"os"
 	"path/filepath"
 )
@@ -52,6 +54,41 @@ func WriteFile(filePath string, data []byte) error {
 	return os.WriteFile(filePath, data, 0644)
 }
 
+// WriteLinesAll writes lines to a file like WriteLines, first creating any missing parent directories
+func WriteLinesAll(filePath string, lines []string) error {
+	if err := EnsureParentDir(filePath); err != nil {
+		return err
+	}
+	return WriteLines(filePath, lines)
+}
+
+// WriteFileAll writes data to a file like WriteFile, first creating any missing parent directories
+func WriteFileAll(filePath string, data []byte) error {
+	if err := EnsureParentDir(filePath); err != nil {
+		return err
+	}
+	return WriteFile(filePath, data)
+}
+
+// EnsureDir creates a directory and any missing parents with mode 0755 if it doesn't exist
+func EnsureDir(path string) error {
+	return os.MkdirAll(path, 0755)
+}
+
+// EnsureParentDir creates the parent directory of a file path if it doesn't exist
+func EnsureParentDir(filePath string) error {
+	return EnsureDir(filepath.Dir(filePath))
+}
+
+// permOrDefault returns the permission bits of mode, or 0644 if none are set
+// A zero permission would create a file that nobody can read, which is never what a copy intends
+func permOrDefault(mode os.FileMode) os.FileMode {
+	if perm := mode.Perm(); perm != 0 {
+		return perm
+	}
+	return 0644
+}
+
 // Exists checks if a file or directory exists
 func Exists(path string) bool {
 	_, err := os.Stat(path)
@@ -108,6 +145,35 @@ func GetExt(filePath string) string {
 	return filepath.Ext(filePath)
 }
 
+// DetectMimeType detects the MIME type of a file by sniffing its first 512 bytes
+// If sniffing only yields "application/octet-stream", the type is guessed from the file extension
+func DetectMimeType(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buffer := make([]byte, 512)
+	n, err := io.ReadFull(file, buffer)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	mimeType := DetectMimeTypeBytes(buffer[:n])
+	if mimeType == "application/octet-stream" {
+		if byExt := mime.TypeByExtension(filepath.Ext(filePath)); byExt != "" {
+			return byExt, nil
+		}
+	}
+	return mimeType, nil
+}
+
+// DetectMimeTypeBytes detects the MIME type of in-memory data using http.DetectContentType
+func DetectMimeTypeBytes(data []byte) string {
+	return http.DetectContentType(data)
+}
+
 // GetBaseName returns the base name of a file
 func GetBaseName(filePath string) string {
 	return filepath.Base(filePath)
@@ -117,3 +183,64 @@ func GetBaseName(filePath string) string {
 func GetDir(filePath string) string {
 	return filepath.Dir(filePath)
 }
+
+// OverwriteFile atomically replaces a file's content while preserving its mode and owner
+// The data is written to a temporary file in the same directory and renamed over the target.
+// New files, and existing files without any permission bits, get mode 0644
+func OverwriteFile(filePath string, data []byte) error {
+	info, err := os.Stat(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	tmpPath, err := writeTempFile(filePath, data, info)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	return os.Rename(tmpPath, filePath)
+}
+
+// writeTempFile writes data to a new temporary file next to filePath and returns its path
+// The file gets the mode and owner recorded in info, or mode 0644 if info is nil
+func writeTempFile(filePath string, data []byte, info os.FileInfo) (string, error) {
+	mode := os.FileMode(0644)
+	if info != nil {
+		mode = permOrDefault(info.Mode())
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".tmp*")
+	if err != nil {
+		return "", err
+	}
+	tmpPath := tmpFile.Name()
+
+	if err := writeAndSync(tmpFile, data); err != nil {
+		os.Remove(tmpPath)
+		return "", err
+	}
+	if err := os.Chmod(tmpPath, mode); err != nil {
+		os.Remove(tmpPath)
+		return "", err
+	}
+	if info != nil {
+		// Preserving the owner usually requires privileges, so failures are ignored
+		_ = chownLike(tmpPath, info)
+	}
+
+	return tmpPath, nil
+}
+
+// writeAndSync writes data to f, flushes it to disk and closes f
+func writeAndSync(f *os.File, data []byte) error {
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,69 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOverwriteFilePreservesMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.txt")
+	if err := os.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := OverwriteFile(path, []byte("new")); err != nil {
+		t.Fatalf("OverwriteFile returned error: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("mode after overwrite = %o, want 600", perm)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "new" {
+		t.Errorf("content after overwrite = %q, want %q", data, "new")
+	}
+}
+
+func TestOverwriteFileNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "new.txt")
+	if err := OverwriteFile(path, []byte("data")); err != nil {
+		t.Fatalf("OverwriteFile returned error: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0644 {
+		t.Errorf("mode of new file = %o, want 644", perm)
+	}
+}
+
+func TestWriteFileMissingDir(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "nested", "file.txt")
+
+	if err := WriteFile(path, []byte("data")); err == nil {
+		t.Error("WriteFile created missing parent directories")
+	}
+	if err := WriteLines(path, []string{"a"}); err == nil {
+		t.Error("WriteLines created missing parent directories")
+	}
+
+	if err := WriteFileAll(path, []byte("data")); err != nil {
+		t.Fatalf("WriteFileAll returned error: %v", err)
+	}
+	if err := WriteLinesAll(filepath.Join(filepath.Dir(path), "other", "lines.txt"), []string{"a"}); err != nil {
+		t.Fatalf("WriteLinesAll returned error: %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+// Package file provides file operation utilities
+package file
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// splitChunkSize is the largest chunk SplitFile reads at a time
+const splitChunkSize = 1024 * 1024
+
+// SplitFile splits a file into parts of at most partSize bytes and returns their paths in order
+// Parts are written to destDir (created if missing) as "<name>.part0001", "<name>.part0002", etc.
+// The file is read in chunks of at most 1MB via ReadChunksStream, so memory use does not depend
+// on partSize. An empty file yields one empty part
+func SplitFile(srcPath, destDir string, partSize int64) ([]string, error) {
+	if partSize <= 0 {
+		return nil, fmt.Errorf("part size must be positive, got %d", partSize)
+	}
+	if err := EnsureDir(destDir); err != nil {
+		return nil, err
+	}
+
+	chunkSize := splitChunkSize
+	if partSize < int64(chunkSize) {
+		chunkSize = int(partSize)
+	}
+
+	var (
+		parts   []string
+		part    *os.File
+		written int64
+	)
+	nextPart := func() error {
+		if part != nil {
+			err := part.Close()
+			part = nil
+			if err != nil {
+				return err
+			}
+		}
+		partPath := filepath.Join(destDir, fmt.Sprintf("%s.part%04d", filepath.Base(srcPath), len(parts)+1))
+		f, err := os.Create(partPath)
+		if err != nil {
+			return err
+		}
+		part, written = f, 0
+		parts = append(parts, partPath)
+		return nil
+	}
+
+	err := ReadChunksStream(srcPath, chunkSize, func(chunk []byte, offset int64) error {
+		for len(chunk) > 0 {
+			if part == nil || written == partSize {
+				if err := nextPart(); err != nil {
+					return err
+				}
+			}
+			n := int64(len(chunk))
+			if n > partSize-written {
+				n = partSize - written
+			}
+			if _, err := part.Write(chunk[:n]); err != nil {
+				return fmt.Errorf("failed to write part %s: %w", part.Name(), err)
+			}
+			written += n
+			chunk = chunk[n:]
+		}
+		return nil
+	})
+	if err == nil && part == nil {
+		err = nextPart()
+	}
+	if part != nil {
+		if closeErr := part.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return parts, err
+}
+
+// JoinFiles concatenates the given part files in order into destPath, reversing SplitFile
+func JoinFiles(partPaths []string, destPath string) error {
+	if err := EnsureParentDir(destPath); err != nil {
+		return err
+	}
+
+	dest, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+
+	for _, partPath := range partPaths {
+		if err := appendPart(dest, partPath); err != nil {
+			return err
+		}
+	}
+
+	return dest.Close()
+}
+
+// appendPart copies the content of the file at partPath to w
+func appendPart(w io.Writer, partPath string) error {
+	part, err := os.Open(partPath)
+	if err != nil {
+		return err
+	}
+	defer part.Close()
+
+	_, err = io.Copy(w, part)
+	return err
+}
@@ -0,0 +1,66 @@
+package file
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSplitFileJoinFilesRoundTrip(t *testing.T) {
+	data := make([]byte, 10000)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	tests := []struct {
+		name      string
+		data      []byte
+		partSize  int64
+		wantParts int
+	}{
+		{"exact multiple", data, 1000, 10},
+		{"remainder", data, 3000, 4},
+		{"single part", data, 10000, 1},
+		{"part larger than file", data, 20000, 1},
+		{"empty file", nil, 100, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			src := filepath.Join(dir, "src.bin")
+			if err := os.WriteFile(src, tt.data, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			parts, err := SplitFile(src, filepath.Join(dir, "parts"), tt.partSize)
+			if err != nil {
+				t.Fatalf("SplitFile returned error: %v", err)
+			}
+			if len(parts) != tt.wantParts {
+				t.Errorf("SplitFile created %d parts, want %d", len(parts), tt.wantParts)
+			}
+			for _, part := range parts {
+				info, err := os.Stat(part)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if info.Size() > tt.partSize {
+					t.Errorf("part %s has %d bytes, more than %d", part, info.Size(), tt.partSize)
+				}
+			}
+
+			joined := filepath.Join(dir, "joined.bin")
+			if err := JoinFiles(parts, joined); err != nil {
+				t.Fatalf("JoinFiles returned error: %v", err)
+			}
+			got, err := os.ReadFile(joined)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tt.data) {
+				t.Error("joined file does not match the original")
+			}
+		})
+	}
+}
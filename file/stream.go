@@ -3,6 +3,10 @@ package file
 
 import (
 	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 )
@@ -57,6 +61,55 @@ func ReadLinesChannel(filePath string, bufferSize int) (<-chan string, <-chan er
 	return lines, errChan
 }
 
+// LineResult carries either a line read from a file or the error that stopped reading
+type LineResult struct {
+	Line    string // The line content
+	LineNum int    // The 1-based line number
+	Err     error  // The error that stopped reading, if any
+}
+
+// ReadLinesChannelCtx reads a file line by line and sends each line to a single channel
+// If an error occurs it is sent as the last result. The channel is closed at EOF, after an error,
+// or when ctx is done, so the reader goroutine does not leak if the consumer stops early
+func ReadLinesChannelCtx(ctx context.Context, filePath string, bufferSize int) <-chan LineResult {
+	results := make(chan LineResult, bufferSize)
+
+	go func() {
+		defer close(results)
+
+		send := func(result LineResult) bool {
+			select {
+			case results <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			send(LineResult{Err: err})
+			return
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		lineNum := 0
+		for scanner.Scan() {
+			lineNum++
+			if !send(LineResult{Line: scanner.Text(), LineNum: lineNum}) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			send(LineResult{LineNum: lineNum, Err: err})
+		}
+	}()
+
+	return results
+}
+
 // ReadChunk reads a file in chunks of specified size
 // Returns the chunk data, number of bytes read, and any error
 func ReadChunk(filePath string, offset int64, chunkSize int) ([]byte, int, error) {
@@ -189,6 +242,44 @@ func ReadLinesWithLimit(filePath string, maxLines int) ([]string, error) {
 	return lines, scanner.Err()
 }
 
+// ReadLineAt reads line lineNum (1-based) of a file, stopping as soon as it has been read
+// An error is returned if the file has fewer than lineNum lines
+func ReadLineAt(filePath string, lineNum int) (string, error) {
+	lines, err := ReadLineRange(filePath, lineNum, lineNum)
+	if err != nil {
+		return "", err
+	}
+	return lines[0], nil
+}
+
+// ReadLineRange reads lines start through end (1-based, inclusive) of a file
+// If the file ends before end, the available lines are returned. An error is returned if
+// the range is invalid or the file has fewer than start lines
+func ReadLineRange(filePath string, start, end int) ([]string, error) {
+	if start < 1 || end < start {
+		return nil, fmt.Errorf("invalid line range %d-%d", start, end)
+	}
+
+	var lines []string
+	errStop := errors.New("stop")
+	err := ReadLinesStream(filePath, func(line string, lineNum int) error {
+		if lineNum >= start {
+			lines = append(lines, line)
+		}
+		if lineNum >= end {
+			return errStop
+		}
+		return nil
+	})
+	if err != nil && err != errStop {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("file %s has fewer than %d lines", filePath, start)
+	}
+	return lines, nil
+}
+
 // ReadChunkWithOffset reads a specific chunk of a file starting at the given offset
 func ReadChunkWithOffset(filePath string, offset int64, size int) ([]byte, error) {
 	file, err := os.Open(filePath)
@@ -211,3 +302,74 @@ func ReadChunkWithOffset(filePath string, offset int64, size int) ([]byte, error
 
 	return buffer[:n], nil
 }
+
+// reverseChunkSize is the size of the blocks read by ReadLinesReverseStream
+const reverseChunkSize = 64 * 1024
+
+// ReadLinesReverse reads all lines from a file in reverse order (last line first)
+func ReadLinesReverse(filePath string) ([]string, error) {
+	var lines []string
+	err := ReadLinesReverseStream(filePath, func(line string) error {
+		lines = append(lines, line)
+		return nil
+	})
+	return lines, err
+}
+
+// ReadLinesReverseStream reads a file backward in chunks and calls the callback for each line,
+// starting with the last line. Lines are split like ReadLines, so a trailing newline does not
+// produce an extra empty line and a trailing "\r" is removed
+func ReadLinesReverseStream(filePath string, callback func(line string) error) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	if size == 0 {
+		return nil
+	}
+
+	offset := size
+	var pending []byte
+	atEnd := true
+
+	for offset > 0 {
+		n := int64(reverseChunkSize)
+		if n > offset {
+			n = offset
+		}
+		offset -= n
+
+		buffer := make([]byte, n)
+		if _, err := file.ReadAt(buffer, offset); err != nil && err != io.EOF {
+			return err
+		}
+		pending = append(buffer, pending...)
+
+		for {
+			idx := bytes.LastIndexByte(pending, '\n')
+			if idx == -1 {
+				break
+			}
+			line := pending[idx+1:]
+			pending = pending[:idx]
+
+			if atEnd && len(line) == 0 {
+				atEnd = false
+				continue
+			}
+			atEnd = false
+			if err := callback(string(bytes.TrimSuffix(line, []byte("\r")))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return callback(string(bytes.TrimSuffix(pending, []byte("\r"))))
+}
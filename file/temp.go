@@ -0,0 +1,55 @@
+// Package file provides file operation utilities
+package file
+
+import (
+	"errors"
+	"os"
+)
+
+// TempFile creates a new temporary file in dir (the system temp directory if empty)
+// The pattern works as in os.CreateTemp; the caller is responsible for closing and removing the file
+func TempFile(dir, pattern string) (*os.File, error) {
+	return os.CreateTemp(dir, pattern)
+}
+
+// TempDir creates a new temporary directory in dir (the system temp directory if empty)
+// The pattern works as in os.MkdirTemp; the caller is responsible for removing the directory
+func TempDir(dir, pattern string) (string, error) {
+	return os.MkdirTemp(dir, pattern)
+}
+
+// WithTempFile creates an empty temporary file, calls fn with its path and removes the file afterwards
+// The file is removed even if fn returns an error or panics
+func WithTempFile(fn func(path string) error) (err error) {
+	f, err := TempFile("", "")
+	if err != nil {
+		return err
+	}
+	path := f.Name()
+	defer func() {
+		if rmErr := os.Remove(path); rmErr != nil && !os.IsNotExist(rmErr) {
+			err = errors.Join(err, rmErr)
+		}
+	}()
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return fn(path)
+}
+
+// WithTempDir creates a temporary directory, calls fn with its path and removes it with its contents afterwards
+// The directory is removed even if fn returns an error or panics
+func WithTempDir(fn func(dir string) error) (err error) {
+	dir, err := TempDir("", "")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rmErr := os.RemoveAll(dir); rmErr != nil {
+			err = errors.Join(err, rmErr)
+		}
+	}()
+
+	return fn(dir)
+}
@@ -0,0 +1,36 @@
+// Package file provides file operation utilities
+package file
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Walk walks the file tree rooted at root in lexical order, calling fn for each file and directory
+// fn may return filepath.SkipDir to skip a directory (or the rest of its parent when called on a file)
+// and filepath.SkipAll to stop walking; any other error stops the walk and is returned
+func Walk(root string, fn func(path string, info os.FileInfo, isDir bool) error) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return fn(path, info, d.IsDir())
+	})
+}
+
+// WalkFilter walks the file tree like Walk, but only calls fn for files whose path matches filter
+// fn is called for every directory regardless of filter, so it can return filepath.SkipDir to prune
+// subtrees; directories are always descended into unless fn prunes them
+func WalkFilter(root string, filter func(path string) bool, fn func(path string, info os.FileInfo, isDir bool) error) error {
+	return Walk(root, func(path string, info os.FileInfo, isDir bool) error {
+		if !isDir && !filter(path) {
+			return nil
+		}
+		return fn(path, info, isDir)
+	})
+}
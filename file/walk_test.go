@@ -0,0 +1,44 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWalkFilterPrunesDirectories(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"a.go", "b.txt", "vendor/c.go", "sub/d.go", "sub/e.txt"} {
+		path := filepath.Join(root, name)
+		if err := WriteFileAll(path, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var files []string
+	err := WalkFilter(root,
+		func(path string) bool { return strings.HasSuffix(path, ".go") },
+		func(path string, info os.FileInfo, isDir bool) error {
+			if isDir {
+				if info.Name() == "vendor" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			files = append(files, filepath.ToSlash(rel))
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("WalkFilter returned error: %v", err)
+	}
+
+	if want := []string{"a.go", "sub/d.go"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("WalkFilter visited %v, want %v", files, want)
+	}
+}
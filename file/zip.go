@@ -0,0 +1,132 @@
+// Package file provides file operation utilities
+package file
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ZipFiles writes the given files into a new zip archive at zipPath
+// Each file is stored under its base name, so files with the same base name collide
+func ZipFiles(zipPath string, files []string) error {
+	if err := EnsureParentDir(zipPath); err != nil {
+		return err
+	}
+
+	out, err := os.Create(zipPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	zw := zip.NewWriter(out)
+	for _, filePath := range files {
+		if err := addToZip(zw, filePath); err != nil {
+			zw.Close()
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	return out.Close()
+}
+
+// Unzip extracts all entries of the zip archive at zipPath into destDir, creating directories as needed
+// Entries that would be extracted outside destDir (e.g., "../evil" or absolute paths) are rejected
+// with an error to guard against Zip Slip. Files keep their stored permissions, except that entries
+// without any permission bits are created with mode 0644
+func Unzip(zipPath, destDir string) error {
+	zr, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	destDir, err = filepath.Abs(destDir)
+	if err != nil {
+		return err
+	}
+	if err := EnsureDir(destDir); err != nil {
+		return err
+	}
+
+	for _, entry := range zr.File {
+		target := filepath.Join(destDir, entry.Name)
+		if target != destDir && !strings.HasPrefix(target, destDir+string(os.PathSeparator)) {
+			return fmt.Errorf("illegal file path in zip archive: %s", entry.Name)
+		}
+
+		if entry.FileInfo().IsDir() {
+			if err := EnsureDir(target); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := extractZipEntry(entry, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addToZip writes the file at filePath into zw under its base name
+func addToZip(zw *zip.Writer, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("cannot add directory to zip archive: %s", filePath)
+	}
+
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = filepath.Base(filePath)
+	header.Method = zip.Deflate
+
+	w, err := zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// extractZipEntry writes a single zip entry to target
+func extractZipEntry(entry *zip.File, target string) error {
+	if err := EnsureParentDir(target); err != nil {
+		return err
+	}
+
+	rc, err := entry.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, permOrDefault(entry.Mode()))
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, rc); err != nil {
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,94 @@
+package file
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestZipFilesUnzipRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.txt")
+	b := filepath.Join(dir, "sub", "b.txt")
+	if err := WriteFileAll(a, []byte("alpha")); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFileAll(b, []byte("beta")); err != nil {
+		t.Fatal(err)
+	}
+
+	zipPath := filepath.Join(dir, "out.zip")
+	if err := ZipFiles(zipPath, []string{a, b}); err != nil {
+		t.Fatalf("ZipFiles returned error: %v", err)
+	}
+	dest := filepath.Join(dir, "extracted")
+	if err := Unzip(zipPath, dest); err != nil {
+		t.Fatalf("Unzip returned error: %v", err)
+	}
+
+	for name, want := range map[string]string{"a.txt": "alpha", "b.txt": "beta"} {
+		got, err := os.ReadFile(filepath.Join(dest, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestUnzipRejectsPathTraversal(t *testing.T) {
+	dir := t.TempDir()
+	zipPath := writeTestZip(t, dir, "../evil.txt", 0644)
+
+	if err := Unzip(zipPath, filepath.Join(dir, "dest")); err == nil {
+		t.Fatal("Unzip accepted an entry outside the destination directory")
+	}
+	if Exists(filepath.Join(dir, "evil.txt")) {
+		t.Error("Unzip wrote a file outside the destination directory")
+	}
+}
+
+func TestUnzipZeroPermissions(t *testing.T) {
+	dir := t.TempDir()
+	zipPath := writeTestZip(t, dir, "file.txt", 0)
+
+	dest := filepath.Join(dir, "dest")
+	if err := Unzip(zipPath, dest); err != nil {
+		t.Fatalf("Unzip returned error: %v", err)
+	}
+	info, err := os.Stat(filepath.Join(dest, "file.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0644 {
+		t.Errorf("mode of extracted file = %o, want 644", perm)
+	}
+}
+
+// writeTestZip creates a zip archive in dir with a single entry of the given name and mode
+func writeTestZip(t *testing.T, dir, name string, mode os.FileMode) string {
+	t.Helper()
+	zipPath := filepath.Join(dir, "test.zip")
+	f, err := os.Create(zipPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	header := &zip.FileHeader{Name: name, Method: zip.Deflate}
+	header.SetMode(mode)
+	w, err := zw.CreateHeader(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("content")); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return zipPath
+}
@@ -1,3 +1,8 @@
 module github.com/cx-luo/go-toolkit
 
 go 1.20
+
+require (
+	golang.org/x/crypto v0.33.0
+	golang.org/x/text v0.22.0
+)
@@ -0,0 +1,18 @@
+// Package boolstr parses the boolean spellings accepted across the toolkit
+package boolstr
+
+import "strings"
+
+// Parse converts s to a bool, case-insensitively and ignoring surrounding whitespace
+// "1", "t", "true", "y", "yes" and "on" are true; "0", "f", "false", "n", "no" and "off" are false.
+// ok is false for any other string
+func Parse(s string) (value bool, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "t", "true", "y", "yes", "on":
+		return true, true
+	case "0", "f", "false", "n", "no", "off":
+		return false, true
+	default:
+		return false, false
+	}
+}
@@ -0,0 +1,174 @@
+// Package jsonutil provides JSON manipulation utilities
+package jsonutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// AggregateByPath applies a numeric aggregate over the elements of the array at arrayPath
+// fieldPath is resolved against each element (an empty fieldPath uses the element itself).
+// Supported operations are "sum", "avg", "min", "max" and "count"
+func AggregateByPath(data interface{}, arrayPath, fieldPath string, op string) (float64, error) {
+	value, err := GetValueByPath(data, arrayPath)
+	if err != nil {
+		return 0, err
+	}
+
+	arr, ok := value.([]interface{})
+	if !ok {
+		return 0, fmt.Errorf("path '%s': expected array, got %T", arrayPath, value)
+	}
+
+	values := make([]float64, 0, len(arr))
+	for i, item := range arr {
+		fieldValue, err := GetValueByPath(item, fieldPath)
+		if err != nil {
+			return 0, fmt.Errorf("element %d: %w", i, err)
+		}
+		f, err := toNumber(fieldValue)
+		if err != nil {
+			return 0, fmt.Errorf("element %d: %w", i, err)
+		}
+		values = append(values, f)
+	}
+
+	return aggregate(values, op)
+}
+
+// SumByPath returns the sum of all numeric values matching a wildcard path (e.g., "orders[*].total")
+// Non-numeric matches are skipped; see AggregateByWildcardPath for strict handling
+func SumByPath(data interface{}, wildcardPath string) (float64, error) {
+	return AggregateByWildcardPath(data, wildcardPath, "sum", true)
+}
+
+// AvgByPath returns the average of all numeric values matching a wildcard path
+// Non-numeric matches are skipped. An error is returned if there are no numeric matches
+func AvgByPath(data interface{}, wildcardPath string) (float64, error) {
+	return AggregateByWildcardPath(data, wildcardPath, "avg", true)
+}
+
+// AggregateByWildcardPath applies a numeric aggregate to all values matching a path with "[*]" wildcards
+// The operations are the same as for AggregateByPath. If skipNonNumeric is false, a non-numeric match
+// is an error; otherwise it is ignored
+func AggregateByWildcardPath(data interface{}, wildcardPath, op string, skipNonNumeric bool) (float64, error) {
+	matches := GetValuesByPath(data, wildcardPath)
+
+	values := make([]float64, 0, len(matches))
+	for i, match := range matches {
+		f, err := toNumber(match)
+		if err != nil {
+			if skipNonNumeric {
+				continue
+			}
+			return 0, fmt.Errorf("match %d: %w", i, err)
+		}
+		values = append(values, f)
+	}
+
+	return aggregate(values, op)
+}
+
+// aggregate applies the named operation to a list of numbers
+func aggregate(values []float64, op string) (float64, error) {
+	switch op {
+	case "count":
+		return float64(len(values)), nil
+	case "sum", "avg":
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		if op == "sum" {
+			return sum, nil
+		}
+		if len(values) == 0 {
+			return 0, fmt.Errorf("cannot compute avg of empty array")
+		}
+		return sum / float64(len(values)), nil
+	case "min", "max":
+		if len(values) == 0 {
+			return 0, fmt.Errorf("cannot compute %s of empty array", op)
+		}
+		result := values[0]
+		for _, v := range values[1:] {
+			if (op == "min" && v < result) || (op == "max" && v > result) {
+				result = v
+			}
+		}
+		return result, nil
+	default:
+		return 0, fmt.Errorf("unsupported aggregate operation: %s", op)
+	}
+}
+
+// toNumber converts a JSON value to float64, failing for non-numeric values
+func toNumber(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value '%s' is not numeric", v)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("value of type %T is not numeric", value)
+	}
+}
+
+// CountLeaves returns the number of scalar values (strings, numbers, bools and nulls) in JSON data
+func CountLeaves(data interface{}) int {
+	count := 0
+	countLeavesRecursive(data, &count)
+	return count
+}
+
+// CountByType counts every node in JSON data, including the root, by its type name
+// The type names are "string", "number", "bool", "object", "array" and "null"
+func CountByType(data interface{}) map[string]int {
+	counts := make(map[string]int)
+	countByTypeRecursive(data, counts)
+	return counts
+}
+
+// countLeavesRecursive recursively counts scalar values
+func countLeavesRecursive(data interface{}, count *int) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for _, val := range v {
+			countLeavesRecursive(val, count)
+		}
+	case []interface{}:
+		for _, val := range v {
+			countLeavesRecursive(val, count)
+		}
+	default:
+		*count++
+	}
+}
+
+// countByTypeRecursive recursively counts nodes by type
+func countByTypeRecursive(data interface{}, counts map[string]int) {
+	counts[getValueType(data)]++
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for _, val := range v {
+			countByTypeRecursive(val, counts)
+		}
+	case []interface{}:
+		for _, val := range v {
+			countByTypeRecursive(val, counts)
+		}
+	}
+}
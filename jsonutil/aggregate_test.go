@@ -0,0 +1,93 @@
+package jsonutil
+
+import "testing"
+
+func TestAggregateByPath(t *testing.T) {
+	data := mustUnmarshal(t, `{
+		"order": {
+			"items": [
+				{"name": "a", "price": 10, "qty": 1},
+				{"name": "b", "price": 2.5, "qty": 4},
+				{"name": "c", "price": "7.5", "qty": 2}
+			]
+		},
+		"empty": []
+	}`)
+
+	tests := []struct {
+		op   string
+		want float64
+	}{
+		{"sum", 20},
+		{"avg", 20.0 / 3.0},
+		{"min", 2.5},
+		{"max", 10},
+		{"count", 3},
+	}
+
+	for _, tt := range tests {
+		got, err := AggregateByPath(data, "order.items", "price", tt.op)
+		if err != nil {
+			t.Errorf("AggregateByPath(%s) returned error: %v", tt.op, err)
+			continue
+		}
+		if diff := got - tt.want; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("AggregateByPath(%s) = %v, want %v", tt.op, got, tt.want)
+		}
+	}
+}
+
+func TestAggregateByPathErrors(t *testing.T) {
+	data := mustUnmarshal(t, `{"empty": [], "items": [{"v": "x"}], "obj": {}}`)
+
+	tests := []struct {
+		name      string
+		arrayPath string
+		fieldPath string
+		op        string
+	}{
+		{"avg of empty array", "empty", "v", "avg"},
+		{"min of empty array", "empty", "v", "min"},
+		{"non-numeric value", "items", "v", "sum"},
+		{"missing field", "items", "missing", "sum"},
+		{"not an array", "obj", "v", "sum"},
+		{"unknown operation", "items", "v", "median"},
+	}
+
+	for _, tt := range tests {
+		if _, err := AggregateByPath(data, tt.arrayPath, tt.fieldPath, tt.op); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+
+	if got, err := AggregateByPath(data, "empty", "v", "sum"); err != nil || got != 0 {
+		t.Errorf("sum of empty array = %v, %v; want 0, nil", got, err)
+	}
+}
+
+func TestSumAndAvgByPath(t *testing.T) {
+	data := mustUnmarshal(t, `{
+		"orders": [{"total": 10}, {"total": "5"}, {"total": "n/a"}, {"note": "none"}],
+		"byRegion": {"west": {"total": 3}, "east": {"total": 1}},
+		"empty": []
+	}`)
+
+	if got, err := SumByPath(data, "orders[*].total"); err != nil || got != 15 {
+		t.Errorf("SumByPath(orders) = %v, %v; want 15, nil", got, err)
+	}
+	if got, err := AvgByPath(data, "orders[*].total"); err != nil || got != 7.5 {
+		t.Errorf("AvgByPath(orders) = %v, %v; want 7.5, nil", got, err)
+	}
+	if got, err := SumByPath(data, "byRegion[*].total"); err != nil || got != 4 {
+		t.Errorf("SumByPath(byRegion) = %v, %v; want 4, nil", got, err)
+	}
+	if got, err := SumByPath(data, "empty[*].total"); err != nil || got != 0 {
+		t.Errorf("SumByPath(empty) = %v, %v; want 0, nil", got, err)
+	}
+	if _, err := AvgByPath(data, "empty[*].total"); err == nil {
+		t.Error("AvgByPath(empty): expected an error")
+	}
+	if _, err := AggregateByWildcardPath(data, "orders[*].total", "sum", false); err == nil {
+		t.Error("AggregateByWildcardPath with non-numeric match: expected an error")
+	}
+}
@@ -0,0 +1,27 @@
+// Package jsonutil provides JSON manipulation utilities
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Pretty reformats a JSON string with two-space indentation
+// If the input is not valid JSON, the parse error from encoding/json is returned unchanged
+func Pretty(jsonStr string) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(jsonStr), "", "  "); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Minify removes insignificant whitespace from a JSON string
+// If the input is not valid JSON, the parse error from encoding/json is returned unchanged
+func Minify(jsonStr string) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(jsonStr)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -4,9 +4,13 @@ package jsonutil
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/cx-luo/go-toolkit/internal/boolstr"
 )
 
 // ConvertValuesToString converts all values in a JSON object to strings
@@ -93,6 +97,9 @@ func ConvertJSONStringValuesToString(jsonStr string) (string, error) {
 }
 
 // GetValueByPath gets a value from JSON data using a path (e.g., "user.name" or "items[0].name")
+// Dots, brackets and backslashes inside keys are escaped with a backslash (e.g., "user\\.email"); a trailing
+// lone backslash has nothing to escape and is kept as part of the key
+// Negative array indices count from the end (e.g., "items[-1]" is the last element)
 func GetValueByPath(data interface{}, path string) (interface{}, error) {
 	if path == "" {
 		return data, nil
@@ -105,47 +112,50 @@ func GetValueByPath(data interface{}, path string) (interface{}, error) {
 		if current == nil {
 			return nil, fmt.Errorf("path segment '%s' at index %d: value is nil", part, i)
 		}
+		next, err := getPart(current, part)
+		if err != nil {
+			return nil, fmt.Errorf("path segment '%s' at index %d: %w", part, i, err)
+		}
+		current = next
+	}
 
-		switch v := current.(type) {
-		case map[string]interface{}:
-			key, _, isArray := parsePart(part)
-			if isArray {
-				return nil, fmt.Errorf("path segment '%s' at index %d: cannot use array index on map", part, i)
-			}
-			var exists bool
-			current, exists = v[key]
-			if !exists {
-				return nil, fmt.Errorf("path segment '%s' at index %d: key '%s' not found", part, i, key)
+	return current, nil
+}
+
+// getPart resolves a single path part (as returned by parsePath) against data
+func getPart(data interface{}, part string) (interface{}, error) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		key, _, isArray := parsePart(part)
+		if isArray {
+			return nil, fmt.Errorf("cannot use array index on map")
+		}
+		value, exists := v[key]
+		if !exists {
+			return nil, fmt.Errorf("key '%s' not found", key)
+		}
+		return value, nil
+	case []interface{}:
+		key, index, isArray := parsePart(part)
+		if isArray {
+			resolved := resolveIndex(index, len(v))
+			if resolved < 0 || resolved >= len(v) {
+				return nil, fmt.Errorf("array index %d out of range", index)
 			}
-		case []interface{}:
-			key, index, isArray := parsePart(part)
-			if isArray {
-				if index < 0 || index >= len(v) {
-					return nil, fmt.Errorf("path segment '%s' at index %d: array index %d out of range", part, i, index)
-				}
-				current = v[index]
-			} else {
-				// Try to find in array elements if they are maps
-				var found bool
-				for _, item := range v {
-					if itemMap, ok := item.(map[string]interface{}); ok {
-						if val, exists := itemMap[key]; exists {
-							current = val
-							found = true
-							break
-						}
-					}
-				}
-				if !found {
-					return nil, fmt.Errorf("path segment '%s' at index %d: key '%s' not found in array elements", part, i, key)
+			return v[resolved], nil
+		}
+		// Try to find in array elements if they are maps
+		for _, item := range v {
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				if value, exists := itemMap[key]; exists {
+					return value, nil
 				}
 			}
-		default:
-			return nil, fmt.Errorf("path segment '%s' at index %d: cannot traverse type %T", part, i, current)
 		}
+		return nil, fmt.Errorf("key '%s' not found in array elements", key)
+	default:
+		return nil, fmt.Errorf("cannot traverse type %T", data)
 	}
-
-	return current, nil
 }
 
 // GetStringByPath gets a string value from JSON data using a path
@@ -184,6 +194,136 @@ func GetBoolByPath(data interface{}, path string) (bool, error) {
 	return convertToBool(value), nil
 }
 
+// GetOrDefault gets a value from JSON data using a path, or defaultValue if the path cannot be resolved
+func GetOrDefault(data interface{}, path string, defaultValue interface{}) interface{} {
+	value, err := GetValueByPath(data, path)
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// GetStringByPathOr gets a string value from JSON data using a path
+// def is returned if the path cannot be resolved, or if the value is null, an object or an array.
+// Numbers and bools are formatted as strings
+func GetStringByPathOr(data interface{}, path string, def string) string {
+	value, err := GetValueByPath(data, path)
+	if err != nil {
+		return def
+	}
+	switch value.(type) {
+	case nil, map[string]interface{}, []interface{}:
+		return def
+	}
+	return convertToString(value)
+}
+
+// GetIntByPathOr gets an int value from JSON data using a path
+// def is returned if the path cannot be resolved, or if the value is null or not numeric (numeric
+// strings such as "42" are accepted). Fractional parts are truncated
+func GetIntByPathOr(data interface{}, path string, def int) int {
+	f, ok := numberByPath(data, path)
+	if !ok || f >= math.MaxInt || f < math.MinInt {
+		return def
+	}
+	return int(f)
+}
+
+// GetFloat64ByPathOr gets a float64 value from JSON data using a path
+// def is returned if the path cannot be resolved, or if the value is null or not numeric (numeric
+// strings such as "1.5" are accepted)
+func GetFloat64ByPathOr(data interface{}, path string, def float64) float64 {
+	f, ok := numberByPath(data, path)
+	if !ok {
+		return def
+	}
+	return f
+}
+
+// GetBoolByPathOr gets a bool value from JSON data using a path
+// def is returned if the path cannot be resolved, or if the value is null or not a bool, a number
+// (non-zero is true) or a bool string accepted by convert.ToBoolE ("true", "yes", "on", "0", "off", etc.)
+func GetBoolByPathOr(data interface{}, path string, def bool) bool {
+	value, err := GetValueByPath(data, path)
+	if err != nil {
+		return def
+	}
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		b, ok := boolstr.Parse(v)
+		if !ok {
+			return def
+		}
+		return b
+	case nil, map[string]interface{}, []interface{}:
+		return def
+	default:
+		f, err := toNumber(v)
+		if err != nil {
+			return def
+		}
+		return f != 0
+	}
+}
+
+// numberByPath gets a finite numeric value at a path, reporting false if there is none
+func numberByPath(data interface{}, path string) (float64, bool) {
+	value, err := GetValueByPath(data, path)
+	if err != nil {
+		return 0, false
+	}
+	f, err := toNumber(value)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+// GetValuesByPath gets all values matching a path that may contain "[*]" wildcards
+// (e.g., "orders[*].total" or "groups[*].items[*].id"). A "[*]" matches every element of an array,
+// or every value of an object in sorted key order. Branches where the path does not exist are skipped,
+// so the result is empty if nothing matches
+func GetValuesByPath(data interface{}, path string) []interface{} {
+	var values []interface{}
+	collectValuesRecursive(data, parsePath(path), &values)
+	return values
+}
+
+// collectValuesRecursive collects the values matching the remaining path parts
+func collectValuesRecursive(data interface{}, parts []string, values *[]interface{}) {
+	if len(parts) == 0 {
+		*values = append(*values, data)
+		return
+	}
+
+	if parts[0] == "[*]" {
+		switch v := data.(type) {
+		case []interface{}:
+			for _, item := range v {
+				collectValuesRecursive(item, parts[1:], values)
+			}
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				collectValuesRecursive(v[key], parts[1:], values)
+			}
+		}
+		return
+	}
+
+	value, err := getPart(data, parts[0])
+	if err != nil {
+		return
+	}
+	collectValuesRecursive(value, parts[1:], values)
+}
+
 // SetValueByPath sets a value in JSON data using a path
 func SetValueByPath(data interface{}, path string, value interface{}) error {
 	if path == "" {
@@ -213,13 +353,33 @@ func SetValueByPath(data interface{}, path string, value interface{}) error {
 
 // FindPaths finds all paths in JSON data that match a pattern or contain a specific value
 func FindPaths(data interface{}, options *FindOptions) ([]string, error) {
+	entries, err := FindEntries(data, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		paths = append(paths, entry.Path)
+	}
+	return paths, nil
+}
+
+// FindEntries finds all paths in JSON data that match the options, along with their values
+func FindEntries(data interface{}, options *FindOptions) ([]PathValue, error) {
 	if options == nil {
 		options = &FindOptions{}
 	}
 
-	var paths []string
-	err := findPathsRecursive(data, "", options, &paths)
-	return paths, err
+	var entries []PathValue
+	err := findPathsRecursive(data, "", options, &entries)
+	return entries, err
+}
+
+// PathValue is a path in JSON data together with the value found at that path
+type PathValue struct {
+	Path  string
+	Value interface{}
 }
 
 // FindOptions provides options for finding paths
@@ -248,13 +408,24 @@ func GetAllPaths(data interface{}) []string {
 }
 
 // parsePath parses a path string into parts
+// Escaped characters (e.g. "\\." in "user\\.email") are kept escaped in the parts
 func parsePath(path string) []string {
 	var parts []string
 	var current strings.Builder
 	inBrackets := false
+	escaped := false
 
 	for _, char := range path {
+		if escaped {
+			current.WriteRune(char)
+			escaped = false
+			continue
+		}
+
 		switch char {
+		case '\\':
+			current.WriteRune(char)
+			escaped = true
 		case '.':
 			if !inBrackets {
 				if current.Len() > 0 {
@@ -289,6 +460,7 @@ func parsePath(path string) []string {
 }
 
 // parsePart parses a path part (e.g., "key", "[0]", "key[0]")
+// Escape sequences in keys are resolved, so "user\\.email" yields the key "user.email"
 func parsePart(part string) (key string, index int, isArray bool) {
 	if strings.HasPrefix(part, "[") && strings.HasSuffix(part, "]") {
 		// Array index only: [0]
@@ -300,10 +472,10 @@ func parsePart(part string) (key string, index int, isArray bool) {
 		return "", idx, true
 	}
 
-	if idx := strings.Index(part, "["); idx != -1 {
+	if open := indexUnescaped(part, '['); open != -1 && strings.HasSuffix(part, "]") {
 		// Key with array index: key[0]
-		key = part[:idx]
-		indexStr := part[idx+1 : strings.Index(part, "]")]
+		key = unescapeKey(part[:open])
+		indexStr := part[open+1 : len(part)-1]
 		idx, err := strconv.Atoi(indexStr)
 		if err != nil {
 			return key, -1, false
@@ -312,7 +484,75 @@ func parsePart(part string) (key string, index int, isArray bool) {
 	}
 
 	// Just a key
-	return part, -1, false
+	return unescapeKey(part), -1, false
+}
+
+// resolveIndex converts a negative array index (counted from the end) to a regular index
+func resolveIndex(index, length int) int {
+	if index < 0 {
+		return length + index
+	}
+	return index
+}
+
+// indexUnescaped returns the index of the first occurrence of c not preceded by a backslash, or -1
+func indexUnescaped(s string, c byte) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// escapeKey escapes the characters that have special meaning in paths
+func escapeKey(key string) string {
+	if !strings.ContainsAny(key, `\.[]`) {
+		return key
+	}
+	var b strings.Builder
+	for _, char := range key {
+		switch char {
+		case '\\', '.', '[', ']':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(char)
+	}
+	return b.String()
+}
+
+// unescapeKey removes the backslash escapes from a path key
+func unescapeKey(key string) string {
+	if !strings.Contains(key, `\`) {
+		return key
+	}
+	var b strings.Builder
+	escaped := false
+	for _, char := range key {
+		if char == '\\' && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		b.WriteRune(char)
+	}
+	if escaped {
+		// A trailing lone backslash has nothing to escape and is kept as is
+		b.WriteByte('\\')
+	}
+	return b.String()
+}
+
+// joinPath appends an escaped object key to a path
+func joinPath(currentPath, key string) string {
+	if currentPath == "" {
+		return escapeKey(key)
+	}
+	return currentPath + "." + escapeKey(key)
 }
 
 // setValueAtPath sets a value at a specific path part
@@ -328,10 +568,11 @@ func setValueAtPath(data interface{}, part string, value interface{}) error {
 		return nil
 	case []interface{}:
 		if isArray {
-			if index < 0 || index >= len(v) {
+			resolved := resolveIndex(index, len(v))
+			if resolved < 0 || resolved >= len(v) {
 				return fmt.Errorf("array index %d out of range", index)
 			}
-			v[index] = value
+			v[resolved] = value
 			return nil
 		}
 		return fmt.Errorf("cannot set key '%s' on array without index", key)
@@ -341,21 +582,17 @@ func setValueAtPath(data interface{}, part string, value interface{}) error {
 }
 
 // findPathsRecursive recursively finds paths matching the options
-func findPathsRecursive(data interface{}, currentPath string, options *FindOptions, paths *[]string) error {
+func findPathsRecursive(data interface{}, currentPath string, options *FindOptions, entries *[]PathValue) error {
 	switch v := data.(type) {
 	case map[string]interface{}:
 		for key, val := range v {
-			newPath := currentPath
-			if newPath != "" {
-				newPath += "."
-			}
-			newPath += key
+			newPath := joinPath(currentPath, key)
 
 			if matchesOptions(val, key, options) {
-				*paths = append(*paths, newPath)
+				*entries = append(*entries, PathValue{Path: newPath, Value: val})
 			}
 
-			if err := findPathsRecursive(val, newPath, options, paths); err != nil {
+			if err := findPathsRecursive(val, newPath, options, entries); err != nil {
 				return err
 			}
 		}
@@ -364,10 +601,10 @@ func findPathsRecursive(data interface{}, currentPath string, options *FindOptio
 			newPath := fmt.Sprintf("%s[%d]", currentPath, i)
 
 			if matchesOptions(val, "", options) {
-				*paths = append(*paths, newPath)
+				*entries = append(*entries, PathValue{Path: newPath, Value: val})
 			}
 
-			if err := findPathsRecursive(val, newPath, options, paths); err != nil {
+			if err := findPathsRecursive(val, newPath, options, entries); err != nil {
 				return err
 			}
 		}
@@ -422,11 +659,7 @@ func getAllPathsRecursive(data interface{}, currentPath string, paths *[]string)
 	switch v := data.(type) {
 	case map[string]interface{}:
 		for key, val := range v {
-			newPath := currentPath
-			if newPath != "" {
-				newPath += "."
-			}
-			newPath += key
+			newPath := joinPath(currentPath, key)
 			*paths = append(*paths, newPath)
 			getAllPathsRecursive(val, newPath, paths)
 		}
@@ -541,7 +774,7 @@ func convertToBool(value interface{}) bool {
 	case bool:
 		return v
 	case string:
-		b, _ := strconv.ParseBool(v)
+		b, _ := boolstr.Parse(v)
 		return b
 	case int:
 		return v != 0
@@ -551,3 +784,76 @@ func convertToBool(value interface{}) bool {
 		return false
 	}
 }
+
+// SetValueByPathCreate sets a value in JSON data using a path, creating missing containers along the way
+// Missing or null object segments become new map[string]interface{} values, and arrays that are too
+// short are grown with nil placeholders up to the index (e.g., setting "items[5]" on a 3-element array
+// grows it to length 6). Since growing an array can replace it, the updated root is returned and
+// should be used instead of data. Existing non-container values on the path are never overwritten.
+// Negative indices count from the end and must refer to an existing element; they never grow an array
+func SetValueByPathCreate(data interface{}, path string, value interface{}) (interface{}, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path cannot be empty")
+	}
+
+	parts := parsePath(path)
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("invalid path")
+	}
+
+	return setValueCreateRecursive(data, parts, "", value)
+}
+
+// setValueCreateRecursive sets value at parts below node and returns the updated node
+func setValueCreateRecursive(node interface{}, parts []string, currentPath string, value interface{}) (interface{}, error) {
+	if len(parts) == 0 {
+		return value, nil
+	}
+
+	part := parts[0]
+	key, index, isArray := parsePart(part)
+	if strings.HasPrefix(part, "[") && !isArray {
+		return nil, fmt.Errorf("path '%s': invalid array index '%s'", currentPath, part)
+	}
+
+	// parsePath splits "key[0]" into "key" and "[0]", so a part is either a key or an index
+	if !isArray {
+		if node == nil {
+			node = make(map[string]interface{})
+		}
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("path '%s': cannot set key '%s' on type %T", currentPath, key, node)
+		}
+
+		child, err := setValueCreateRecursive(m[key], parts[1:], joinPath(currentPath, key), value)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = child
+		return m, nil
+	}
+
+	if node == nil {
+		node = []interface{}{}
+	}
+	arr, ok := node.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("path '%s': cannot use array index on type %T", currentPath, node)
+	}
+
+	resolved := resolveIndex(index, len(arr))
+	if resolved < 0 {
+		return nil, fmt.Errorf("path '%s': array index %d out of range", currentPath, index)
+	}
+	for len(arr) <= resolved {
+		arr = append(arr, nil)
+	}
+
+	child, err := setValueCreateRecursive(arr[resolved], parts[1:], fmt.Sprintf("%s[%d]", currentPath, resolved), value)
+	if err != nil {
+		return nil, err
+	}
+	arr[resolved] = child
+	return arr, nil
+}
@@ -0,0 +1,266 @@
+package jsonutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetValueByPathCreate(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		path  string
+		value interface{}
+		want  string
+	}{
+		{"grow array with placeholders", `{"items":[1,2,3]}`, "items[5]", "x", `{"items":[1,2,3,null,null,"x"]}`},
+		{"create object in new element", `{"items":[]}`, "items[1].status", "ok", `{"items":[null,{"status":"ok"}]}`},
+		{"create missing containers", `{}`, "a.b[0].c", true, `{"a":{"b":[{"c":true}]}}`},
+		{"replace null with object", `{"a":null}`, "a.b", 1.0, `{"a":{"b":1}}`},
+		{"negative index", `{"a":[1,2]}`, "a[-1]", 9.0, `{"a":[1,9]}`},
+		{"escaped key", `{}`, `a\.b`, "v", `{"a.b":"v"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SetValueByPathCreate(mustUnmarshal(t, tt.data), tt.path, tt.value)
+			if err != nil {
+				t.Fatalf("SetValueByPathCreate returned error: %v", err)
+			}
+			if want := mustUnmarshal(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("SetValueByPathCreate = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSetValueByPathCreateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		path string
+	}{
+		{"key on scalar", `{"a":1}`, "a.b"},
+		{"index on object", `{"a":{}}`, "a[0]"},
+		{"negative index out of range", `{"a":[1]}`, "a[-2]"},
+		{"invalid index", `{"a":[]}`, "a[x]"},
+		{"empty path", `{}`, ""},
+	}
+
+	for _, tt := range tests {
+		if _, err := SetValueByPathCreate(mustUnmarshal(t, tt.data), tt.path, 1); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestGetByPathOr(t *testing.T) {
+	data := mustUnmarshal(t, `{"name":"abc","null":null,"n":42,"f":1.5,"s":"7","b":true,"bs":"false","obj":{},"arr":[1],"yes":"Yes","off":" off ","N":"N"}`)
+
+	intTests := []struct {
+		path string
+		want int
+	}{
+		{"n", 42}, {"f", 1}, {"s", 7}, {"name", 5}, {"null", 5}, {"missing", 5}, {"obj", 5}, {"b", 5},
+	}
+	for _, tt := range intTests {
+		if got := GetIntByPathOr(data, tt.path, 5); got != tt.want {
+			t.Errorf("GetIntByPathOr(%q) = %d, want %d", tt.path, got, tt.want)
+		}
+	}
+
+	floatTests := []struct {
+		path string
+		want float64
+	}{
+		{"f", 1.5}, {"n", 42}, {"s", 7}, {"name", 2.5}, {"null", 2.5}, {"arr", 2.5},
+	}
+	for _, tt := range floatTests {
+		if got := GetFloat64ByPathOr(data, tt.path, 2.5); got != tt.want {
+			t.Errorf("GetFloat64ByPathOr(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+
+	boolTests := []struct {
+		path string
+		want bool
+	}{
+		{"b", true}, {"bs", false}, {"n", true}, {"name", true}, {"null", true}, {"missing", true},
+		{"yes", true}, {"off", false}, {"N", false},
+	}
+	for _, tt := range boolTests {
+		if got := GetBoolByPathOr(data, tt.path, true); got != tt.want {
+			t.Errorf("GetBoolByPathOr(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+
+	stringTests := []struct {
+		path string
+		want string
+	}{
+		{"name", "abc"}, {"n", "42"}, {"b", "true"}, {"null", "def"}, {"obj", "def"}, {"missing", "def"},
+	}
+	for _, tt := range stringTests {
+		if got := GetStringByPathOr(data, tt.path, "def"); got != tt.want {
+			t.Errorf("GetStringByPathOr(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+
+	if got := GetOrDefault(data, "missing", "x"); got != "x" {
+		t.Errorf("GetOrDefault(missing) = %v, want x", got)
+	}
+}
+
+func TestGetValuesByPath(t *testing.T) {
+	data := mustUnmarshal(t, `{
+		"orders": [{"total": 10}, {"total": 2.5}, {"note": "none"}],
+		"byRegion": {"west": {"total": 3}, "east": {"total": 1}},
+		"groups": [{"items": [{"id": 1}, {"id": 2}]}, {"items": [{"id": 3}]}],
+		"a.b": [{"c": "escaped"}]
+	}`)
+
+	tests := []struct {
+		name string
+		path string
+		want []interface{}
+	}{
+		{"wildcard over array", "orders[*].total", []interface{}{10.0, 2.5}},
+		{"wildcard over object", "byRegion[*].total", []interface{}{1.0, 3.0}},
+		{"nested wildcards", "groups[*].items[*].id", []interface{}{1.0, 2.0, 3.0}},
+		{"wildcard then index", "groups[*].items[0].id", []interface{}{1.0, 3.0}},
+		{"index then wildcard", "groups[-1].items[*].id", []interface{}{3.0}},
+		{"escaped key", `a\.b[*].c`, []interface{}{"escaped"}},
+		{"wildcard over scalar", "orders[0].total[*]", nil},
+		{"missing path", "missing[*].x", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetValuesByPath(data, tt.path); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetValuesByPath(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAllPathsRoundTrip(t *testing.T) {
+	const doc = `{
+		"a.b": {"c[0]": 1, "d\\e": [true, {"f]": "x"}]},
+		"plain": [[1, 2], {"g.h": null}],
+		"trailing\\": "slash"
+	}`
+	data := mustUnmarshal(t, doc)
+
+	paths := GetAllPaths(data)
+	for _, want := range []string{`a\.b`, `a\.b.c\[0\]`, `a\.b.d\\e[1].f\]`, `plain[1].g\.h`, `trailing\\`} {
+		if !contains(paths, want) {
+			t.Errorf("GetAllPaths is missing %q: %q", want, paths)
+		}
+	}
+
+	for _, path := range paths {
+		if _, err := GetValueByPath(data, path); err != nil {
+			t.Errorf("GetValueByPath(%q) returned error: %v", path, err)
+			continue
+		}
+
+		fresh := mustUnmarshal(t, doc)
+		if err := SetValueByPath(fresh, path, "sentinel"); err != nil {
+			t.Errorf("SetValueByPath(%q) returned error: %v", path, err)
+			continue
+		}
+		if got, err := GetValueByPath(fresh, path); err != nil || got != "sentinel" {
+			t.Errorf("GetValueByPath(%q) after set = %v, %v; want sentinel", path, got, err)
+		}
+	}
+}
+
+func TestTrailingBackslash(t *testing.T) {
+	data := mustUnmarshal(t, `{"a\\": 1, "b": {"c\\": 2}}`)
+
+	if got, err := GetValueByPath(data, `a\`); err != nil || got != 1.0 {
+		t.Errorf("GetValueByPath(%q) = %v, %v; want 1", `a\`, got, err)
+	}
+	if got, err := GetValueByPath(data, `b.c\`); err != nil || got != 2.0 {
+		t.Errorf("GetValueByPath(%q) = %v, %v; want 2", `b.c\`, got, err)
+	}
+	if err := SetValueByPath(data, `b.c\`, 3.0); err != nil {
+		t.Fatalf("SetValueByPath returned error: %v", err)
+	}
+	if got := data.(map[string]interface{})["b"].(map[string]interface{})[`c\`]; got != 3.0 {
+		t.Errorf("value after SetValueByPath = %v, want 3", got)
+	}
+}
+
+// contains reports whether s is one of values
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestNegativeIndices(t *testing.T) {
+	const doc = `{"items": ["a", "b", "c"]}`
+
+	getTests := []struct {
+		path string
+		want interface{}
+	}{
+		{"items[-1]", "c"},
+		{"items[-3]", "a"},
+		{"items[0]", "a"},
+	}
+	for _, tt := range getTests {
+		if got, err := GetValueByPath(mustUnmarshal(t, doc), tt.path); err != nil || got != tt.want {
+			t.Errorf("GetValueByPath(%q) = %v, %v; want %v", tt.path, got, err, tt.want)
+		}
+	}
+
+	for _, path := range []string{"items[-4]", "items[3]"} {
+		if _, err := GetValueByPath(mustUnmarshal(t, doc), path); err == nil {
+			t.Errorf("GetValueByPath(%q): expected an out-of-range error", path)
+		}
+	}
+
+	setTests := []struct {
+		path string
+		want string
+	}{
+		{"items[-1]", `{"items": ["a", "b", "x"]}`},
+		{"items[-3]", `{"items": ["x", "b", "c"]}`},
+	}
+	for _, tt := range setTests {
+		data := mustUnmarshal(t, doc)
+		if err := SetValueByPath(data, tt.path, "x"); err != nil {
+			t.Errorf("SetValueByPath(%q) returned error: %v", tt.path, err)
+			continue
+		}
+		if want := mustUnmarshal(t, tt.want); !reflect.DeepEqual(data, want) {
+			t.Errorf("SetValueByPath(%q) = %v, want %v", tt.path, data, want)
+		}
+	}
+
+	data := mustUnmarshal(t, doc)
+	if err := SetValueByPath(data, "items[-4]", "x"); err == nil {
+		t.Error("SetValueByPath(items[-4]): expected an out-of-range error")
+	}
+	if want := mustUnmarshal(t, doc); !reflect.DeepEqual(data, want) {
+		t.Errorf("SetValueByPath(items[-4]) modified the data: %v", data)
+	}
+}
+
+func TestSetValueByPathCreateNegativeIndexDoesNotGrow(t *testing.T) {
+	for _, path := range []string{"items[-4]", "items[-4].name", "empty[-1]"} {
+		data := mustUnmarshal(t, `{"items": ["a", "b", "c"], "empty": []}`)
+		got, err := SetValueByPathCreate(data, path, "x")
+		if err == nil {
+			t.Errorf("SetValueByPathCreate(%q) = %v, expected an out-of-range error", path, got)
+		}
+		if want := mustUnmarshal(t, `{"items": ["a", "b", "c"], "empty": []}`); !reflect.DeepEqual(data, want) {
+			t.Errorf("SetValueByPathCreate(%q) modified the data: %v", path, data)
+		}
+	}
+}
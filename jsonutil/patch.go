@@ -0,0 +1,113 @@
+// Package jsonutil provides JSON manipulation utilities
+package jsonutil
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MergePatch applies an RFC 7396 merge patch to target and returns the result
+// Keys whose patch value is null are removed; non-object patches replace the target entirely
+func MergePatch(target, patch interface{}) interface{} {
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	result := make(map[string]interface{})
+	if targetMap, ok := target.(map[string]interface{}); ok {
+		for key, val := range targetMap {
+			result[key] = val
+		}
+	}
+
+	for key, val := range patchMap {
+		if val == nil {
+			delete(result, key)
+			continue
+		}
+		result[key] = MergePatch(result[key], val)
+	}
+
+	return result
+}
+
+// CreateMergePatch creates an RFC 7396 merge patch that transforms original into modified
+// Deleted keys are mapped to null. An error is returned if modified contains null object
+// values, since a merge patch cannot express them
+func CreateMergePatch(original, modified interface{}) (interface{}, error) {
+	originalMap, ok1 := original.(map[string]interface{})
+	modifiedMap, ok2 := modified.(map[string]interface{})
+	if !ok1 || !ok2 {
+		if err := checkNoNullMembers(modified, ""); err != nil {
+			return nil, err
+		}
+		return modified, nil
+	}
+
+	return createMergePatchRecursive(originalMap, modifiedMap, "")
+}
+
+// createMergePatchRecursive builds the merge patch between two objects
+func createMergePatchRecursive(original, modified map[string]interface{}, currentPath string) (map[string]interface{}, error) {
+	patch := make(map[string]interface{})
+
+	for key := range original {
+		if _, exists := modified[key]; !exists {
+			patch[key] = nil
+		}
+	}
+
+	for key, modVal := range modified {
+		newPath := joinPath(currentPath, key)
+
+		if modVal == nil {
+			return nil, fmt.Errorf("path '%s': null values cannot be expressed in a merge patch", newPath)
+		}
+
+		origVal, exists := original[key]
+		if exists {
+			origValMap, ok1 := origVal.(map[string]interface{})
+			modValMap, ok2 := modVal.(map[string]interface{})
+			if ok1 && ok2 {
+				subPatch, err := createMergePatchRecursive(origValMap, modValMap, newPath)
+				if err != nil {
+					return nil, err
+				}
+				if len(subPatch) > 0 {
+					patch[key] = subPatch
+				}
+				continue
+			}
+			if reflect.DeepEqual(origVal, modVal) {
+				continue
+			}
+		}
+
+		if err := checkNoNullMembers(modVal, newPath); err != nil {
+			return nil, err
+		}
+		patch[key] = modVal
+	}
+
+	return patch, nil
+}
+
+// checkNoNullMembers reports an error if an object (at any nesting level) has a null member
+func checkNoNullMembers(value interface{}, currentPath string) error {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for key, val := range m {
+		newPath := joinPath(currentPath, key)
+
+		if val == nil {
+			return fmt.Errorf("path '%s': null values cannot be expressed in a merge patch", newPath)
+		}
+		if err := checkNoNullMembers(val, newPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package jsonutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCreateMergePatchRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		modified string
+	}{
+		{
+			name:     "changed scalar",
+			original: `{"a":1,"b":"x"}`,
+			modified: `{"a":2,"b":"x"}`,
+		},
+		{
+			name:     "nested objects",
+			original: `{"user":{"name":"John","address":{"city":"Paris","zip":"75001"}}}`,
+			modified: `{"user":{"name":"John","address":{"city":"Lyon","zip":"75001","country":"FR"}}}`,
+		},
+		{
+			name:     "deleted keys",
+			original: `{"a":1,"b":{"c":2,"d":3},"e":4}`,
+			modified: `{"a":1,"b":{"c":2}}`,
+		},
+		{
+			name:     "array replacement",
+			original: `{"tags":["a","b","c"],"items":[{"id":1}]}`,
+			modified: `{"tags":["b"],"items":[{"id":1},{"id":2}]}`,
+		},
+		{
+			name:     "object replaced by scalar",
+			original: `{"a":{"b":1}}`,
+			modified: `{"a":"flat"}`,
+		},
+		{
+			name:     "non-object documents",
+			original: `[1,2]`,
+			modified: `{"a":1}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := mustUnmarshal(t, tt.original)
+			modified := mustUnmarshal(t, tt.modified)
+
+			patch, err := CreateMergePatch(original, modified)
+			if err != nil {
+				t.Fatalf("CreateMergePatch returned error: %v", err)
+			}
+
+			result := MergePatch(original, patch)
+			if !reflect.DeepEqual(result, modified) {
+				t.Errorf("MergePatch(original, patch) = %v, want %v (patch %v)", result, modified, patch)
+			}
+		})
+	}
+}
+
+func TestCreateMergePatchDeletedKeysAreNull(t *testing.T) {
+	original := mustUnmarshal(t, `{"a":1,"b":{"c":2,"d":3}}`)
+	modified := mustUnmarshal(t, `{"b":{"c":2}}`)
+
+	patch, err := CreateMergePatch(original, modified)
+	if err != nil {
+		t.Fatalf("CreateMergePatch returned error: %v", err)
+	}
+
+	want := mustUnmarshal(t, `{"a":null,"b":{"d":null}}`)
+	if !reflect.DeepEqual(patch, want) {
+		t.Errorf("CreateMergePatch = %v, want %v", patch, want)
+	}
+}
+
+func TestCreateMergePatchRejectsNullValues(t *testing.T) {
+	original := mustUnmarshal(t, `{"a":1}`)
+	modified := mustUnmarshal(t, `{"a":null}`)
+
+	if _, err := CreateMergePatch(original, modified); err == nil {
+		t.Error("CreateMergePatch accepted a null value in modified")
+	}
+}
+
+func mustUnmarshal(t *testing.T, s string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("invalid test JSON %s: %v", s, err)
+	}
+	return v
+}
@@ -0,0 +1,43 @@
+// Package jsonutil provides JSON manipulation utilities
+package jsonutil
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Redact returns a copy of JSON data in which the value of every key matching keyPattern
+// (a regex, e.g. "password|token|secret") is replaced with replacement
+// Keys are matched case-insensitively, so "password" also redacts "Password" and "PASSWORD".
+// Nested maps and arrays are traversed; the input is not modified
+func Redact(data interface{}, keyPattern string, replacement string) (interface{}, error) {
+	re, err := regexp.Compile("(?i)" + keyPattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key pattern: %w", err)
+	}
+	return redactRecursive(data, re, replacement), nil
+}
+
+// redactRecursive copies data, replacing the values of matching keys
+func redactRecursive(data interface{}, re *regexp.Regexp, replacement string) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			if re.MatchString(key) {
+				result[key] = replacement
+				continue
+			}
+			result[key] = redactRecursive(val, re, replacement)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, val := range v {
+			result[i] = redactRecursive(val, re, replacement)
+		}
+		return result
+	default:
+		return v
+	}
+}
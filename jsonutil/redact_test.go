@@ -0,0 +1,55 @@
+package jsonutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	const doc = `{
+		"user": "alice",
+		"Password": "hunter2",
+		"auth": {"TOKEN": "abc", "scopes": ["read"]},
+		"sessions": [{"id": 1, "secret": "s1"}, {"id": 2, "nested": [{"api_Secret": "s2"}]}]
+	}`
+	data := mustUnmarshal(t, doc)
+
+	got, err := Redact(data, "password|token|secret", "***")
+	if err != nil {
+		t.Fatalf("Redact returned error: %v", err)
+	}
+
+	want := mustUnmarshal(t, `{
+		"user": "alice",
+		"Password": "***",
+		"auth": {"TOKEN": "***", "scopes": ["read"]},
+		"sessions": [{"id": 1, "secret": "***"}, {"id": 2, "nested": [{"api_Secret": "***"}]}]
+	}`)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Redact = %v, want %v", got, want)
+	}
+	if original := mustUnmarshal(t, doc); !reflect.DeepEqual(data, original) {
+		t.Errorf("Redact modified its input: %v", data)
+	}
+}
+
+func TestRedactTopLevelArray(t *testing.T) {
+	data := []interface{}{map[string]interface{}{"token": "abc"}, "token"}
+
+	got, err := Redact(data, "^token$", "x")
+	if err != nil {
+		t.Fatalf("Redact returned error: %v", err)
+	}
+	if want := []interface{}{map[string]interface{}{"token": "x"}, "token"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Redact = %v, want %v", got, want)
+	}
+	if data[0].(map[string]interface{})["token"] != "abc" {
+		t.Error("Redact modified its input")
+	}
+}
+
+func TestRedactInvalidPattern(t *testing.T) {
+	if _, err := Redact(map[string]interface{}{}, "(", "x"); err == nil {
+		t.Error("Redact with an invalid pattern: expected an error")
+	}
+}
@@ -0,0 +1,77 @@
+// Package jsonutil provides JSON manipulation utilities
+package jsonutil
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// Schema describes lightweight structural expectations for JSON data
+// Paths use the same syntax as GetValueByPath (e.g., "user.name" or "items[0].id")
+type Schema struct {
+	// Required lists paths that must exist
+	Required []string
+	// Types maps paths to their expected type ("string", "number", "bool", "object", "array" or "null")
+	// Paths that do not exist are only reported if they are also listed in Required
+	Types map[string]string
+	// Patterns maps paths to regex patterns that their string values must match
+	// Paths that do not exist are only reported if they are also listed in Required
+	Patterns map[string]string
+}
+
+// Validate checks data against schema and returns every violation found
+// Each error names the offending path. A nil result means the data is valid
+func Validate(data interface{}, schema Schema) []error {
+	var errs []error
+
+	for _, path := range schema.Required {
+		if !HasPath(data, path) {
+			errs = append(errs, fmt.Errorf("path '%s': required value is missing", path))
+		}
+	}
+
+	for _, path := range sortedKeys(schema.Types) {
+		value, err := GetValueByPath(data, path)
+		if err != nil {
+			continue
+		}
+		expected := schema.Types[path]
+		if actual := getValueType(value); actual != expected {
+			errs = append(errs, fmt.Errorf("path '%s': expected %s, got %s", path, expected, actual))
+		}
+	}
+
+	for _, path := range sortedKeys(schema.Patterns) {
+		value, err := GetValueByPath(data, path)
+		if err != nil {
+			continue
+		}
+		pattern := schema.Patterns[path]
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("path '%s': invalid pattern: %w", path, err))
+			continue
+		}
+		str, ok := value.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("path '%s': expected string to match pattern, got %s", path, getValueType(value)))
+			continue
+		}
+		if !re.MatchString(str) {
+			errs = append(errs, fmt.Errorf("path '%s': value '%s' does not match pattern '%s'", path, str, pattern))
+		}
+	}
+
+	return errs
+}
+
+// sortedKeys returns the keys of a string map in sorted order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
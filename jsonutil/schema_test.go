@@ -0,0 +1,71 @@
+package jsonutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateReportsEveryViolation(t *testing.T) {
+	data := mustUnmarshal(t, `{
+		"id": "42",
+		"user": {"name": "alice", "email": "not-an-email", "age": "old"},
+		"items": [{"sku": "A1", "qty": 1}, {"sku": 7, "qty": "two"}]
+	}`)
+
+	schema := Schema{
+		Required: []string{"id", "user.name", "user.address.city", "items[1].price"},
+		Types: map[string]string{
+			"id":           "number",
+			"user":         "object",
+			"user.age":     "number",
+			"items":        "array",
+			"items[1].qty": "number",
+			"missing":      "string",
+		},
+		Patterns: map[string]string{
+			"user.email":   `^[^@]+@[^@]+$`,
+			"items[0].sku": `^[A-Z][0-9]$`,
+			"items[1].sku": `^[A-Z][0-9]$`,
+		},
+	}
+
+	var got []string
+	for _, err := range Validate(data, schema) {
+		got = append(got, err.Error())
+	}
+
+	want := []string{
+		"path 'user.address.city': required value is missing",
+		"path 'items[1].price': required value is missing",
+		"path 'id': expected number, got string",
+		"path 'items[1].qty': expected number, got string",
+		"path 'user.age': expected number, got string",
+		"path 'items[1].sku': expected string to match pattern, got number",
+		"path 'user.email': value 'not-an-email' does not match pattern '^[^@]+@[^@]+$'",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate errors:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestValidateValid(t *testing.T) {
+	data := mustUnmarshal(t, `{"user": {"name": "alice", "tags": [], "manager": null}}`)
+
+	schema := Schema{
+		Required: []string{"user.name"},
+		Types:    map[string]string{"user.name": "string", "user.tags": "array", "user.manager": "null"},
+		Patterns: map[string]string{"user.name": "^a"},
+	}
+	if errs := Validate(data, schema); errs != nil {
+		t.Errorf("Validate returned %v, want nil", errs)
+	}
+}
+
+func TestValidateInvalidPattern(t *testing.T) {
+	data := mustUnmarshal(t, `{"name": "alice"}`)
+
+	errs := Validate(data, Schema{Patterns: map[string]string{"name": "("}})
+	if len(errs) != 1 {
+		t.Fatalf("Validate returned %d errors, want 1: %v", len(errs), errs)
+	}
+}
@@ -1,6 +1,13 @@
 // Package maputil provides map manipulation utilities
 package maputil
 
+import (
+	"sort"
+	"strings"
+
+	"github.com/cx-luo/go-toolkit/internal/constraints"
+)
+
 // Keys returns all keys from a map
 func Keys[K comparable, V any](m map[K]V) []K {
 	keys := make([]K, 0, len(m))
@@ -10,6 +17,46 @@ func Keys[K comparable, V any](m map[K]V) []K {
 	return keys
 }
 
+// SortedKeys returns all keys from a map in ascending order
+func SortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
+	keys := Keys(m)
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// EachSorted calls fn for each key-value pair in ascending key order
+func EachSorted[K constraints.Ordered, V any](m map[K]V, fn func(K, V)) {
+	for _, k := range SortedKeys(m) {
+		fn(k, m[k])
+	}
+}
+
+// Entry is a key-value pair from a map
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Entries returns all key-value pairs from a map, in no particular order
+func Entries[K comparable, V any](m map[K]V) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+// SortedEntries returns all key-value pairs from a map in ascending key order
+func SortedEntries[K constraints.Ordered, V any](m map[K]V) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(m))
+	for _, k := range SortedKeys(m) {
+		entries = append(entries, Entry[K, V]{Key: k, Value: m[k]})
+	}
+	return entries
+}
+
 // Values returns all values from a map
 func Values[K comparable, V any](m map[K]V) []V {
 	values := make([]V, 0, len(m))
@@ -35,11 +82,41 @@ func GetOrDefault[K comparable, V any](m map[K]V, key K, defaultValue V) V {
 
 // Merge merges multiple maps into one (later maps override earlier ones)
 func Merge[K comparable, V any](maps ...map[K]V) map[K]V {
+	return MergeFunc(func(_ K, _, b V) V { return b }, maps...)
+}
+
+// MergeFunc merges multiple maps into one, calling resolve when a key appears in more than one map
+// resolve receives the value accumulated so far (a) and the value from the later map (b)
+func MergeFunc[K comparable, V any](resolve func(key K, a, b V) V, maps ...map[K]V) map[K]V {
 	result := make(map[K]V)
 	for _, m := range maps {
 		for k, v := range m {
-			result[k] = v
+			if existing, exists := result[k]; exists {
+				result[k] = resolve(k, existing, v)
+			} else {
+				result[k] = v
+			}
+		}
+	}
+	return result
+}
+
+// MergeDeep recursively merges src into dst and returns the result as a new map
+// When both values at a key are maps they are merged; otherwise src's value wins.
+// Neither input is modified
+func MergeDeep(dst, src map[string]interface{}) map[string]interface{} {
+	result := DeepCopy(dst)
+	if result == nil {
+		result = make(map[string]interface{}, len(src))
+	}
+	for k, srcVal := range src {
+		srcMap, srcIsMap := srcVal.(map[string]interface{})
+		dstMap, dstIsMap := result[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			result[k] = MergeDeep(dstMap, srcMap)
+			continue
 		}
+		result[k] = deepCopyValue(srcVal)
 	}
 	return result
 }
@@ -55,6 +132,32 @@ func Filter[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
 	return result
 }
 
+// Pick returns a new map containing only the given keys that exist in m
+func Pick[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, exists := m[k]; exists {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Omit returns a new map containing all entries of m except the given keys
+func Omit[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	omitted := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		omitted[k] = struct{}{}
+	}
+	result := make(map[K]V, len(m))
+	for k, v := range m {
+		if _, skip := omitted[k]; !skip {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 // Map applies a function to each key-value pair and returns a new map
 func Map[K comparable, V any, R any](m map[K]V, mapper func(K, V) R) map[K]R {
 	result := make(map[K]R, len(m))
@@ -64,6 +167,26 @@ func Map[K comparable, V any, R any](m map[K]V, mapper func(K, V) R) map[K]R {
 	return result
 }
 
+// MapKeys returns a new map with each key transformed by fn and the values unchanged
+// If fn maps several keys to the same new key, the last one visited wins; since map iteration
+// order is random, which value is kept is unspecified
+func MapKeys[K1, K2 comparable, V any](m map[K1]V, fn func(K1) K2) map[K2]V {
+	result := make(map[K2]V, len(m))
+	for k, v := range m {
+		result[fn(k)] = v
+	}
+	return result
+}
+
+// MapValues returns a new map with the same keys and each value transformed by fn
+func MapValues[K comparable, V1, V2 any](m map[K]V1, fn func(V1) V2) map[K]V2 {
+	result := make(map[K]V2, len(m))
+	for k, v := range m {
+		result[k] = fn(v)
+	}
+	return result
+}
+
 // Invert inverts a map (swaps keys and values)
 func Invert[K, V comparable](m map[K]V) map[V]K {
 	result := make(map[V]K, len(m))
@@ -99,3 +222,189 @@ func Copy[K comparable, V any](m map[K]V) map[K]V {
 	return result
 }
 
+// DeepCopy creates a deep copy of a map, recursively cloning nested maps and []interface{} slices
+// Other values (including pointers) are copied as is
+func DeepCopy(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = deepCopyValue(v)
+	}
+	return result
+}
+
+// deepCopyValue copies nested maps and slices, returning other values as is
+func deepCopyValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return DeepCopy(val)
+	case []interface{}:
+		if val == nil {
+			return val
+		}
+		result := make([]interface{}, len(val))
+		for i, item := range val {
+			result[i] = deepCopyValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// Equal checks if two maps have the same keys and equal values
+func Equal[K, V comparable](a, b map[K]V) bool {
+	return EqualFunc(a, b, func(x, y V) bool { return x == y })
+}
+
+// EqualFunc checks if two maps have the same keys, comparing values with eq
+func EqualFunc[K comparable, V any](a, b map[K]V, eq func(V, V) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, va := range a {
+		vb, exists := b[k]
+		if !exists || !eq(va, vb) {
+			return false
+		}
+	}
+	return true
+}
+
+// FromSlice builds a map from a slice, keyed by keyFn (later elements win on key collision)
+func FromSlice[T any, K comparable](slice []T, keyFn func(T) K) map[K]T {
+	result := make(map[K]T, len(slice))
+	for _, item := range slice {
+		result[keyFn(item)] = item
+	}
+	return result
+}
+
+// FromSliceFunc builds a map from a slice using fn to produce each key-value pair
+// Later elements win on key collision
+func FromSliceFunc[T any, K comparable, V any](slice []T, fn func(T) (K, V)) map[K]V {
+	result := make(map[K]V, len(slice))
+	for _, item := range slice {
+		k, v := fn(item)
+		result[k] = v
+	}
+	return result
+}
+
+// GroupCount counts the occurrences of each distinct item in a slice
+func GroupCount[T comparable](items []T) map[T]int {
+	result := make(map[T]int)
+	for _, item := range items {
+		result[item]++
+	}
+	return result
+}
+
+// GroupCountBy counts the items of a slice by the key returned from keyFn
+func GroupCountBy[T any, K comparable](items []T, keyFn func(T) K) map[K]int {
+	result := make(map[K]int)
+	for _, item := range items {
+		result[keyFn(item)]++
+	}
+	return result
+}
+
+// KeyOf returns a key whose value equals value, and whether one was found
+// Map iteration order is random, so if several keys match, any one of them may be returned
+func KeyOf[K, V comparable](m map[K]V, value V) (K, bool) {
+	for k, v := range m {
+		if v == value {
+			return k, true
+		}
+	}
+	var zero K
+	return zero, false
+}
+
+// KeysOf returns all keys whose value equals value, in no particular order
+func KeysOf[K, V comparable](m map[K]V, value V) []K {
+	keys := make([]K, 0)
+	for k, v := range m {
+		if v == value {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// SumValues returns the sum of all values in a map
+func SumValues[K comparable, V constraints.Number](m map[K]V) V {
+	var sum V
+	for _, v := range m {
+		sum += v
+	}
+	return sum
+}
+
+// MaxValue returns the key and value of the largest value in a map
+// ok is false if the map is empty. If several keys share the maximum, any one of them may be returned
+func MaxValue[K comparable, V constraints.Number](m map[K]V) (key K, value V, ok bool) {
+	for k, v := range m {
+		if !ok || v > value {
+			key, value, ok = k, v, true
+		}
+	}
+	return key, value, ok
+}
+
+// MinValue returns the key and value of the smallest value in a map
+// ok is false if the map is empty. If several keys share the minimum, any one of them may be returned
+func MinValue[K comparable, V constraints.Number](m map[K]V) (key K, value V, ok bool) {
+	for k, v := range m {
+		if !ok || v < value {
+			key, value, ok = k, v, true
+		}
+	}
+	return key, value, ok
+}
+
+// Flatten converts a nested map into a single-level map with dotted keys (e.g. "a.b.c")
+// Only nested map[string]interface{} values are flattened; empty nested maps are kept as values
+func Flatten(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	flattenRecursive(m, "", result)
+	return result
+}
+
+// flattenRecursive flattens m into result, prefixing keys with prefix
+func flattenRecursive(m map[string]interface{}, prefix string, result map[string]interface{}) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			flattenRecursive(nested, key, result)
+			continue
+		}
+		result[key] = v
+	}
+}
+
+// Unflatten rebuilds a nested map from a map with dotted keys, reversing Flatten
+// Keys are applied in sorted order, so a nested key (e.g. "a.b") replaces a conflicting
+// non-map value at its parent key ("a")
+func Unflatten(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, key := range SortedKeys(m) {
+		parts := strings.Split(key, ".")
+		current := result
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := current[part].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				current[part] = next
+			}
+			current = next
+		}
+		current[parts[len(parts)-1]] = deepCopyValue(m[key])
+	}
+	return result
+}
@@ -0,0 +1,131 @@
+package maputil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeepCopyIsolatesNestedValues(t *testing.T) {
+	original := map[string]interface{}{
+		"name":   "svc",
+		"limits": map[string]interface{}{"cpu": 1, "tags": []interface{}{"a", map[string]interface{}{"b": 1}}},
+		"ports":  []interface{}{80, 443},
+	}
+
+	c := DeepCopy(original)
+	if !reflect.DeepEqual(c, original) {
+		t.Fatalf("DeepCopy = %v, want %v", c, original)
+	}
+
+	c["name"] = "changed"
+	c["limits"].(map[string]interface{})["cpu"] = 2
+	tags := c["limits"].(map[string]interface{})["tags"].([]interface{})
+	tags[0] = "z"
+	tags[1].(map[string]interface{})["b"] = 2
+	c["ports"].([]interface{})[0] = 8080
+
+	want := map[string]interface{}{
+		"name":   "svc",
+		"limits": map[string]interface{}{"cpu": 1, "tags": []interface{}{"a", map[string]interface{}{"b": 1}}},
+		"ports":  []interface{}{80, 443},
+	}
+	if !reflect.DeepEqual(original, want) {
+		t.Errorf("modifying the copy changed the original: %v", original)
+	}
+
+	if DeepCopy(nil) != nil {
+		t.Error("DeepCopy(nil) != nil")
+	}
+}
+
+func TestMergeDeep(t *testing.T) {
+	dst := map[string]interface{}{
+		"db":    map[string]interface{}{"host": "localhost", "port": 5432},
+		"tags":  []interface{}{"a"},
+		"mode":  map[string]interface{}{"debug": true},
+		"level": "info",
+	}
+	src := map[string]interface{}{
+		"db":    map[string]interface{}{"port": 6543, "user": "admin"},
+		"tags":  []interface{}{"b"},
+		"mode":  "release",
+		"level": map[string]interface{}{"default": "warn"},
+	}
+
+	got := MergeDeep(dst, src)
+	want := map[string]interface{}{
+		"db":    map[string]interface{}{"host": "localhost", "port": 6543, "user": "admin"},
+		"tags":  []interface{}{"b"},
+		"mode":  "release",
+		"level": map[string]interface{}{"default": "warn"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeDeep = %v, want %v", got, want)
+	}
+
+	if _, ok := dst["db"].(map[string]interface{})["user"]; ok {
+		t.Error("MergeDeep modified dst")
+	}
+	got["level"].(map[string]interface{})["default"] = "error"
+	if src["level"].(map[string]interface{})["default"] != "warn" {
+		t.Error("the result shares nested maps with src")
+	}
+}
+
+func TestFlattenUnflattenRoundTrip(t *testing.T) {
+	nested := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": map[string]interface{}{"c": 1},
+			"d": []interface{}{1, 2},
+		},
+		"e":     "x",
+		"empty": map[string]interface{}{},
+	}
+
+	flat := Flatten(nested)
+	wantFlat := map[string]interface{}{
+		"a.b.c": 1,
+		"a.d":   []interface{}{1, 2},
+		"e":     "x",
+		"empty": map[string]interface{}{},
+	}
+	if !reflect.DeepEqual(flat, wantFlat) {
+		t.Errorf("Flatten = %v, want %v", flat, wantFlat)
+	}
+
+	if got := Unflatten(flat); !reflect.DeepEqual(got, nested) {
+		t.Errorf("Unflatten(Flatten(m)) = %v, want %v", got, nested)
+	}
+}
+
+func TestUnflattenConflictingKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		flat map[string]interface{}
+		want map[string]interface{}
+	}{
+		{
+			"nested key replaces scalar parent",
+			map[string]interface{}{"a": 1, "a.b": 2},
+			map[string]interface{}{"a": map[string]interface{}{"b": 2}},
+		},
+		{
+			"deeper key replaces scalar at intermediate level",
+			map[string]interface{}{"a.b": 1, "a.b.c": 2, "a.d": 3},
+			map[string]interface{}{"a": map[string]interface{}{"b": map[string]interface{}{"c": 2}, "d": 3}},
+		},
+		{
+			"nested key merges into map parent",
+			map[string]interface{}{"a": map[string]interface{}{}, "a.b": 2},
+			map[string]interface{}{"a": map[string]interface{}{"b": 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unflatten(tt.flat); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unflatten(%v) = %v, want %v", tt.flat, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,12 @@
 // Package slice provides slice manipulation utilities
 package slice
 
+import (
+	"sort"
+
+	"github.com/cx-luo/go-toolkit/internal/constraints"
+)
+
 // Contains checks if a slice contains a value
 func Contains[T comparable](slice []T, value T) bool {
 	for _, v := range slice {
@@ -21,6 +27,31 @@ func IndexOf[T comparable](slice []T, value T) int {
 	return -1
 }
 
+// BinarySearch searches a sorted slice for target in O(log n)
+// It returns the index of target and true if found, or the index where target would be inserted
+// to keep the slice sorted and false. The slice must be sorted in ascending order
+func BinarySearch[T constraints.Ordered](slice []T, target T) (index int, found bool) {
+	return BinarySearchFunc(slice, func(v T) int {
+		if v < target {
+			return -1
+		}
+		if v > target {
+			return 1
+		}
+		return 0
+	})
+}
+
+// BinarySearchFunc searches a sorted slice in O(log n) using cmp, which compares an element to the
+// target and returns a negative number if the element is smaller, zero if it matches and a positive
+// number if it is larger. Results are as for BinarySearch. The slice must be sorted consistently with cmp
+func BinarySearchFunc[T any](slice []T, cmp func(T) int) (index int, found bool) {
+	index = sort.Search(len(slice), func(i int) bool {
+		return cmp(slice[i]) >= 0
+	})
+	return index, index < len(slice) && cmp(slice[index]) == 0
+}
+
 // Remove removes the first occurrence of value from slice
 func Remove[T comparable](slice []T, value T) []T {
 	index := IndexOf(slice, value)
@@ -121,6 +152,28 @@ func Flatten[T any](slices [][]T) []T {
 	return result
 }
 
+// Concat joins any number of slices into a new slice
+func Concat[T any](slices ...[]T) []T {
+	return Flatten(slices)
+}
+
+// Repeat returns a slice containing count copies of value (empty if count <= 0)
+func Repeat[T any](value T, count int) []T {
+	if count < 0 {
+		count = 0
+	}
+	result := make([]T, count)
+	Fill(result, value)
+	return result
+}
+
+// Fill sets every element of a slice to value in place
+func Fill[T any](slice []T, value T) {
+	for i := range slice {
+		slice[i] = value
+	}
+}
+
 // Intersect returns the intersection of two slices
 func Intersect[T comparable](slice1, slice2 []T) []T {
 	set := make(map[T]bool)
@@ -156,6 +209,60 @@ func Difference[T comparable](slice1, slice2 []T) []T {
 	return result
 }
 
+// IntersectBy returns the elements of slice1 whose key also occurs in slice2, comparing by keyFn
+// Order follows slice1 and only the first element with a given key is kept
+func IntersectBy[T any, K comparable](slice1, slice2 []T, keyFn func(T) K) []T {
+	set := keySet(slice2, keyFn)
+	result := make([]T, 0)
+	for _, v := range slice1 {
+		if set[keyFn(v)] {
+			result = append(result, v)
+		}
+	}
+	return uniqueBy(result, keyFn)
+}
+
+// UnionBy returns the elements of both slices with duplicate keys removed, comparing by keyFn
+// Order follows slice1 then slice2, and the first element with a given key is kept
+func UnionBy[T any, K comparable](slice1, slice2 []T, keyFn func(T) K) []T {
+	return uniqueBy(Concat(slice1, slice2), keyFn)
+}
+
+// DifferenceBy returns the elements of slice1 whose key does not occur in slice2, comparing by keyFn
+func DifferenceBy[T any, K comparable](slice1, slice2 []T, keyFn func(T) K) []T {
+	set := keySet(slice2, keyFn)
+	result := make([]T, 0)
+	for _, v := range slice1 {
+		if !set[keyFn(v)] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// keySet returns the set of keys of the elements of a slice
+func keySet[T any, K comparable](slice []T, keyFn func(T) K) map[K]bool {
+	set := make(map[K]bool, len(slice))
+	for _, v := range slice {
+		set[keyFn(v)] = true
+	}
+	return set
+}
+
+// uniqueBy removes elements whose key has already been seen, keeping the first occurrence
+func uniqueBy[T any, K comparable](slice []T, keyFn func(T) K) []T {
+	seen := make(map[K]bool)
+	result := make([]T, 0, len(slice))
+	for _, v := range slice {
+		k := keyFn(v)
+		if !seen[k] {
+			seen[k] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
 // IsEmpty checks if a slice is empty
 func IsEmpty[T any](slice []T) bool {
 	return len(slice) == 0
@@ -206,3 +313,74 @@ func Skip[T any](slice []T, n int) []T {
 	return slice[n:]
 }
 
+// Rotate returns a new slice with elements shifted left by n (negative n shifts right), wrapping around
+func Rotate[T any](slice []T, n int) []T {
+	if len(slice) <= 1 {
+		return slice
+	}
+	n %= len(slice)
+	if n < 0 {
+		n += len(slice)
+	}
+	result := make([]T, 0, len(slice))
+	result = append(result, slice[n:]...)
+	return append(result, slice[:n]...)
+}
+
+// Equal checks if two slices have the same length and the same elements in order
+// A nil slice and an empty slice are considered equal
+func Equal[T comparable](a, b []T) bool {
+	return EqualBy(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualBy checks if two slices have the same length and elements that are equal according to eq
+func EqualBy[T any](a, b []T, eq func(T, T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of elements that satisfy the predicate
+func Count[T any](slice []T, predicate func(T) bool) int {
+	count := 0
+	for _, v := range slice {
+		if predicate(v) {
+			count++
+		}
+	}
+	return count
+}
+
+// CountValue returns the number of occurrences of value in slice
+func CountValue[T comparable](slice []T, value T) int {
+	count := 0
+	for _, v := range slice {
+		if v == value {
+			count++
+		}
+	}
+	return count
+}
+
+// ForEach calls fn for each element of a slice with its index
+func ForEach[T any](slice []T, fn func(index int, value T)) {
+	for i, v := range slice {
+		fn(i, v)
+	}
+}
+
+// ForEachErr calls fn for each element of a slice with its index, stopping at the first error
+func ForEachErr[T any](slice []T, fn func(index int, value T) error) error {
+	for i, v := range slice {
+		if err := fn(i, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package slice
+
+import "testing"
+
+var benchmarkInts = func() []int {
+	s := make([]int, 10000)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}()
+
+func isEven(x int) bool {
+	return x%2 == 0
+}
+
+func TestCount(t *testing.T) {
+	if got, want := Count(benchmarkInts, isEven), len(Filter(benchmarkInts, isEven)); got != want {
+		t.Errorf("Count = %d, want %d", got, want)
+	}
+	if got := CountValue([]string{"a", "b", "a"}, "a"); got != 2 {
+		t.Errorf("CountValue = %d, want 2", got)
+	}
+}
+
+func BenchmarkCount(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Count(benchmarkInts, isEven)
+	}
+}
+
+func BenchmarkLenFilter(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = len(Filter(benchmarkInts, isEven))
+	}
+}
@@ -3,13 +3,20 @@ package stringutil
 
 import (
 	"crypto/rand"
-	"encoding/hex"
+	"fmt"
+	"html"
+	"math/big"
+	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"unicode"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 // IsEmpty checks if a string is empty or contains only whitespace
+// It is equivalent to IsBlank; use IsStrictEmpty to check for a zero-length string
 func IsEmpty(s string) bool {
 	return strings.TrimSpace(s) == ""
 }
@@ -19,6 +26,29 @@ func IsNotEmpty(s string) bool {
 	return !IsEmpty(s)
 }
 
+// IsBlank checks if a string is empty or contains only whitespace
+func IsBlank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+// IsStrictEmpty checks if a string has zero length; unlike IsEmpty, whitespace-only strings are not empty
+func IsStrictEmpty(s string) bool {
+	return len(s) == 0
+}
+
+// HasContent checks if a string contains at least one non-whitespace character
+func HasContent(s string) bool {
+	return !IsBlank(s)
+}
+
+// DefaultIfBlank returns fallback if s is empty or contains only whitespace, and s otherwise
+func DefaultIfBlank(s, fallback string) string {
+	if IsBlank(s) {
+		return fallback
+	}
+	return s
+}
+
 // Trim removes leading and trailing whitespace
 func Trim(s string) string {
 	return strings.TrimSpace(s)
@@ -34,6 +64,49 @@ func ContainsCaseSensitive(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
 
+// ContainsAny checks if a string contains any of the substrings (case-insensitive)
+// Unlike strings.ContainsAny, this matches whole substrings rather than individual characters
+func ContainsAny(s string, substrs ...string) bool {
+	lower := strings.ToLower(s)
+	for _, substr := range substrs {
+		if strings.Contains(lower, strings.ToLower(substr)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll checks if a string contains all of the substrings (case-insensitive)
+func ContainsAll(s string, substrs ...string) bool {
+	lower := strings.ToLower(s)
+	for _, substr := range substrs {
+		if !strings.Contains(lower, strings.ToLower(substr)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAnyCaseSensitive checks if a string contains any of the substrings (case-sensitive)
+func ContainsAnyCaseSensitive(s string, substrs ...string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAllCaseSensitive checks if a string contains all of the substrings (case-sensitive)
+func ContainsAllCaseSensitive(s string, substrs ...string) bool {
+	for _, substr := range substrs {
+		if !strings.Contains(s, substr) {
+			return false
+		}
+	}
+	return true
+}
+
 // StartsWith checks if a string starts with a prefix
 func StartsWith(s, prefix string) bool {
 	return strings.HasPrefix(s, prefix)
@@ -86,13 +159,40 @@ func SnakeToCamel(s string) string {
 	return strings.Join(parts, "")
 }
 
-// RandomString generates a random string of specified length
+// Character sets for RandomStringFromCharset
+const (
+	Alpha        = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	Numeric      = "0123456789"
+	Alphanumeric = Alpha + Numeric
+	Hex          = "0123456789abcdef"
+)
+
+// RandomString generates a random hex string of specified length
 func RandomString(length int) (string, error) {
-	bytes := make([]byte, length/2+1)
-	if _, err := rand.Read(bytes); err != nil {
-		return "", err
+	return RandomStringFromCharset(length, Hex)
+}
+
+// RandomStringFromCharset generates a random string of specified length using runes from charset
+// Runes are selected uniformly with crypto/rand
+func RandomStringFromCharset(length int, charset string) (string, error) {
+	if length < 0 {
+		return "", fmt.Errorf("length cannot be negative: %d", length)
+	}
+	runes := []rune(charset)
+	if len(runes) == 0 {
+		return "", fmt.Errorf("charset cannot be empty")
+	}
+
+	limit := big.NewInt(int64(len(runes)))
+	result := make([]rune, length)
+	for i := range result {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", err
+		}
+		result[i] = runes[n.Int64()]
 	}
-	return hex.EncodeToString(bytes)[:length], nil
+	return string(result), nil
 }
 
 // RemoveAll removes all occurrences of a substring
@@ -110,6 +210,62 @@ func ReplaceAll(s, old, new string) string {
 	return strings.ReplaceAll(s, old, new)
 }
 
+// ReplaceMultiple performs all replacements in a single pass, so replaced text is never replaced again
+// When keys overlap at the same position the longest key wins. Empty keys are ignored
+func ReplaceMultiple(s string, replacements map[string]string) string {
+	keys := make([]string, 0, len(replacements))
+	for old := range replacements {
+		if old != "" {
+			keys = append(keys, old)
+		}
+	}
+	if len(keys) == 0 {
+		return s
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	oldnew := make([]string, 0, len(keys)*2)
+	for _, old := range keys {
+		oldnew = append(oldnew, old, replacements[old])
+	}
+	return strings.NewReplacer(oldnew...).Replace(s)
+}
+
+// CountOccurrences counts the non-overlapping occurrences of substr in s
+// An empty substr returns 0
+func CountOccurrences(s, substr string) int {
+	if substr == "" {
+		return 0
+	}
+	return strings.Count(s, substr)
+}
+
+// IndexOfAll returns the byte offsets of all non-overlapping occurrences of substr in s
+// An empty substr returns an empty slice
+func IndexOfAll(s, substr string) []int {
+	indexes := make([]int, 0)
+	if substr == "" {
+		return indexes
+	}
+
+	offset := 0
+	for {
+		idx := strings.Index(s[offset:], substr)
+		if idx == -1 {
+			break
+		}
+		indexes = append(indexes, offset+idx)
+		offset += idx + len(substr)
+	}
+	return indexes
+}
+
 // Split splits a string by separator
 func Split(s, sep string) []string {
 	return strings.Split(s, sep)
@@ -152,6 +308,26 @@ func IsAlphanumeric(s string) bool {
 	return matched
 }
 
+// IsEmail checks if a string is a syntactically valid email address
+// This is only a syntax check; it does not verify that the address can receive mail
+func IsEmail(s string) bool {
+	if len(s) > 254 {
+		return false
+	}
+	matched, _ := regexp.MatchString(`^[a-zA-Z0-9.!#$%&'*+/=?^_`+"`"+`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`, s)
+	return matched
+}
+
+// IsURL checks if a string is an absolute URL with a scheme and a host (e.g. "https://example.com")
+func IsURL(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false
+	}
+	matched, _ := regexp.MatchString(`^[a-zA-Z][a-zA-Z0-9+.-]*$`, u.Scheme)
+	return matched && u.Host != ""
+}
+
 // Truncate truncates a string to a maximum length
 func Truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -171,3 +347,360 @@ func TruncateWithEllipsis(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// AbbreviateMiddle shortens a string to at most maxLen runes by replacing its middle with sep
+// The start and end are kept, with the start getting the extra rune when the split is uneven,
+// e.g. AbbreviateMiddle("abcdefghij", 7, "...") returns "ab...ij". If maxLen is not longer than sep,
+// the string is simply truncated to maxLen runes
+func AbbreviateMiddle(s string, maxLen int, sep string) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	if maxLen <= 0 {
+		return ""
+	}
+
+	sepLen := len([]rune(sep))
+	if maxLen <= sepLen {
+		return string(runes[:maxLen])
+	}
+
+	keep := maxLen - sepLen
+	head := (keep + 1) / 2
+	tail := keep / 2
+	return string(runes[:head]) + sep + string(runes[len(runes)-tail:])
+}
+
+// WordCount counts the whitespace-separated words in a string
+func WordCount(s string) int {
+	return len(strings.FieldsFunc(s, unicode.IsSpace))
+}
+
+// Wrap wraps a string so that no line exceeds width runes, breaking on whitespace
+// Words longer than width are kept intact and overflow the line. Existing line breaks are kept,
+// but within each line runs of whitespace (including tabs) are collapsed to a single space and
+// leading and trailing whitespace is dropped. A width <= 0 returns s unchanged
+func Wrap(s string, width int) string {
+	return wrap(s, width, false)
+}
+
+// WrapHard wraps a string like Wrap, but splits words longer than width
+// Whitespace is normalized the same way as in Wrap
+func WrapHard(s string, width int) string {
+	return wrap(s, width, true)
+}
+
+// wrap wraps each line of s independently, preserving existing line breaks
+func wrap(s string, width int, hard bool) string {
+	if width <= 0 {
+		return s
+	}
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = wrapLine(line, width, hard)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapLine wraps a single line without existing line breaks
+func wrapLine(line string, width int, hard bool) string {
+	var result strings.Builder
+	lineLen := 0
+
+	for _, word := range strings.FieldsFunc(line, unicode.IsSpace) {
+		runes := []rune(word)
+
+		if lineLen > 0 && lineLen+1+len(runes) <= width {
+			result.WriteByte(' ')
+			result.WriteString(word)
+			lineLen += 1 + len(runes)
+			continue
+		}
+
+		if lineLen > 0 {
+			result.WriteByte('\n')
+			lineLen = 0
+		}
+
+		for hard && len(runes) > width {
+			result.WriteString(string(runes[:width]))
+			result.WriteByte('\n')
+			runes = runes[width:]
+		}
+		result.WriteString(string(runes))
+		lineLen = len(runes)
+	}
+
+	return result.String()
+}
+
+// DetectLineEnding detects the line-ending style of a string
+// Returns "\n", "\r\n", "\r", "mixed" if more than one style is used, or "" if there are no line breaks
+func DetectLineEnding(s string) string {
+	var lf, crlf, cr bool
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\r':
+			if i+1 < len(s) && s[i+1] == '\n' {
+				crlf = true
+				i++
+			} else {
+				cr = true
+			}
+		case '\n':
+			lf = true
+		}
+	}
+
+	switch {
+	case lf && !crlf && !cr:
+		return "\n"
+	case crlf && !lf && !cr:
+		return "\r\n"
+	case cr && !lf && !crlf:
+		return "\r"
+	case lf || crlf || cr:
+		return "mixed"
+	default:
+		return ""
+	}
+}
+
+// ConvertLineEndings converts all line endings ("\r\n", "\r" or "\n") in a string to target
+func ConvertLineEndings(s, target string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	if target == "\n" {
+		return s
+	}
+	return strings.ReplaceAll(s, "\n", target)
+}
+
+// Mask keeps the first visiblePrefix and last visibleSuffix runes and replaces the rest with maskChar
+// If the string is not longer than the visible counts combined, every rune is masked
+func Mask(s string, visiblePrefix, visibleSuffix int, maskChar rune) string {
+	runes := []rune(s)
+	if visiblePrefix < 0 {
+		visiblePrefix = 0
+	}
+	if visibleSuffix < 0 {
+		visibleSuffix = 0
+	}
+	if len(runes) <= visiblePrefix+visibleSuffix {
+		return strings.Repeat(string(maskChar), len(runes))
+	}
+
+	for i := visiblePrefix; i < len(runes)-visibleSuffix; i++ {
+		runes[i] = maskChar
+	}
+	return string(runes)
+}
+
+// MaskEmail masks the local part of an email address while preserving the domain
+// The first rune of the local part stays visible (e.g. "john@example.com" -> "j***@example.com")
+func MaskEmail(s string) string {
+	at := strings.LastIndex(s, "@")
+	if at == -1 {
+		return Mask(s, 0, 0, '*')
+	}
+	return Mask(s[:at], 1, 0, '*') + s[at:]
+}
+
+// Levenshtein returns the edit distance between two strings, counted in runes
+// The comparison is case-sensitive; lowercase both strings first for case-insensitive matching
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j] + 1
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+			if prev[j-1]+cost < curr[j] {
+				curr[j] = prev[j-1] + cost
+			}
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// Similarity returns a similarity ratio in [0, 1] based on the Levenshtein distance
+// Two empty strings are considered identical. The comparison is case-sensitive
+func Similarity(a, b string) float64 {
+	maxLen := len([]rune(a))
+	if l := len([]rune(b)); l > maxLen {
+		maxLen = l
+	}
+	if maxLen == 0 {
+		return 1
+	}
+	return 1 - float64(Levenshtein(a, b))/float64(maxLen)
+}
+
+// Slugify converts a string into a URL slug (e.g. "Hello, World!" -> "hello-world")
+// Accents are removed with RemoveAccents (é -> e); other characters outside [a-z0-9],
+// including letters without a decomposition such as "ø" or "ß", are stripped
+func Slugify(s string) string {
+	return SlugifyWithSeparator(s, '-')
+}
+
+// SlugifyWithSeparator converts a string into a slug using sep between words
+func SlugifyWithSeparator(s string, sep rune) string {
+	var result strings.Builder
+	pendingSep := false
+
+	for _, r := range RemoveAccents(strings.ToLower(s)) {
+		if unicode.IsSpace(r) || r == '_' || r == '-' || r == sep {
+			pendingSep = result.Len() > 0
+			continue
+		}
+		pendingSep = writeSlugRune(&result, r, sep, pendingSep)
+	}
+
+	return result.String()
+}
+
+// writeSlugRune writes r to the slug if it is allowed, emitting a pending separator first
+func writeSlugRune(b *strings.Builder, r, sep rune, pendingSep bool) bool {
+	if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+		return pendingSep
+	}
+	if pendingSep {
+		b.WriteRune(sep)
+	}
+	b.WriteRune(r)
+	return false
+}
+
+// RemoveAccents strips diacritical marks from a string (e.g. "café" -> "cafe", "naïve" -> "naive")
+// The string is decomposed (NFD), combining marks are dropped and the rest is recomposed (NFC).
+// Letters that are not composed with a mark, such as "ø" or "ß", are left unchanged
+func RemoveAccents(s string) string {
+	var result strings.Builder
+	result.Grow(len(s))
+	for _, r := range norm.NFD.String(s) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		result.WriteRune(r)
+	}
+	return norm.NFC.String(result.String())
+}
+
+// EscapeHTML escapes the special HTML characters <, >, &, ' and "
+func EscapeHTML(s string) string {
+	return html.EscapeString(s)
+}
+
+// UnescapeHTML unescapes HTML entities such as "&lt;" or "&#39;"
+func UnescapeHTML(s string) string {
+	return html.UnescapeString(s)
+}
+
+// StripHTMLTags removes HTML tags and comments from a string, keeping the text between them
+// A "<" that does not start a tag (e.g. in "a < b") is kept. Quoted attribute values may contain ">".
+// An unterminated tag at the end of the input is dropped. Entities are not unescaped
+func StripHTMLTags(s string) string {
+	var result strings.Builder
+	inTag := false
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if !inTag {
+			if c == '<' && i+1 < len(s) && isTagStart(s[i+1]) {
+				if strings.HasPrefix(s[i:], "<!--") {
+					end := strings.Index(s[i+4:], "-->")
+					if end == -1 {
+						break
+					}
+					i += 4 + end + 2
+					continue
+				}
+				inTag = true
+				continue
+			}
+			result.WriteByte(c)
+			continue
+		}
+
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			inTag = false
+		}
+	}
+
+	return result.String()
+}
+
+// isTagStart checks if a byte following "<" starts a tag
+func isTagStart(c byte) bool {
+	return c == '/' || c == '!' || c == '?' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// Capitalize converts the first rune of a string to title case and the rest to lower case
+func Capitalize(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if i == 0 {
+			runes[i] = unicode.ToTitle(r)
+		} else {
+			runes[i] = unicode.ToLower(r)
+		}
+	}
+	return string(runes)
+}
+
+// CapitalizeWords capitalizes each whitespace-separated word, preserving the whitespace
+// It can be used as a replacement for the deprecated strings.Title
+func CapitalizeWords(s string) string {
+	runes := []rune(s)
+	wordStart := true
+	for i, r := range runes {
+		switch {
+		case unicode.IsSpace(r):
+			wordStart = true
+		case wordStart:
+			runes[i] = unicode.ToTitle(r)
+			wordStart = false
+		default:
+			runes[i] = unicode.ToLower(r)
+		}
+	}
+	return string(runes)
+}
+
+// SwapCase converts upper case runes to lower case and lower case runes to upper case
+func SwapCase(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			runes[i] = unicode.ToLower(r)
+		case unicode.IsLower(r):
+			runes[i] = unicode.ToUpper(r)
+		}
+	}
+	return string(runes)
+}
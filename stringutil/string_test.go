@@ -0,0 +1,128 @@
+package stringutil
+
+import "testing"
+
+func TestLineEndings(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		detect string
+		toLF   string
+		toCRLF string
+	}{
+		{"LF", "a\nb\n", "\n", "a\nb\n", "a\r\nb\r\n"},
+		{"CRLF", "a\r\nb\r\n", "\r\n", "a\nb\n", "a\r\nb\r\n"},
+		{"CR", "a\rb\r", "\r", "a\nb\n", "a\r\nb\r\n"},
+		{"mixed", "a\r\nb\nc\rd", "mixed", "a\nb\nc\nd", "a\r\nb\r\nc\r\nd"},
+		{"none", "abc", "", "abc", "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectLineEnding(tt.input); got != tt.detect {
+				t.Errorf("DetectLineEnding(%q) = %q, want %q", tt.input, got, tt.detect)
+			}
+			if got := ConvertLineEndings(tt.input, "\n"); got != tt.toLF {
+				t.Errorf("ConvertLineEndings(%q, LF) = %q, want %q", tt.input, got, tt.toLF)
+			}
+			if got := ConvertLineEndings(tt.input, "\r\n"); got != tt.toCRLF {
+				t.Errorf("ConvertLineEndings(%q, CRLF) = %q, want %q", tt.input, got, tt.toCRLF)
+			}
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"kitten", "sitting", 3},
+		{"abc", "", 3},
+		{"héllo", "hello", 1},
+		{"héllo", "héllo", 0},
+		{"日本語", "日本", 1},
+		{"日本語", "中国語", 2},
+		{"café", "cafe", 1},
+		{"Hello", "hello", 1},
+	}
+
+	for _, tt := range tests {
+		if got := Levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := Levenshtein(tt.b, tt.a); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"", "", 1},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+		{"héllo", "hello", 0.8},
+		{"日本語", "日本", 2.0 / 3.0},
+	}
+
+	for _, tt := range tests {
+		got := Similarity(tt.a, tt.b)
+		if diff := got - tt.want; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("Similarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Hello, World!", "hello-world"},
+		{"  Crème Brûlée_recipe  ", "creme-brulee-recipe"},
+		{"ÉCOLE Ñandú", "ecole-nandu"},
+		{"a -- b", "a-b"},
+		{"日本語", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Slugify(tt.input); got != tt.want {
+			t.Errorf("Slugify(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		want  string
+		hard  string
+	}{
+		{"fits", "hello world", 20, "hello world", "hello world"},
+		{"breaks on spaces", "the quick brown fox", 10, "the quick\nbrown fox", "the quick\nbrown fox"},
+		{"long word", "a verylongword b", 5, "a\nverylongword\nb", "a\nveryl\nongwo\nrd b"},
+		{"collapses whitespace", "a  b\t\tc   d", 80, "a b c d", "a b c d"},
+		{"trims lines", "  indented\t\n\tnext  ", 80, "indented\nnext", "indented\nnext"},
+		{"keeps line breaks", "a\n\nb", 80, "a\n\nb", "a\n\nb"},
+		{"counts runes", "héllo wörld", 5, "héllo\nwörld", "héllo\nwörld"},
+		{"non-positive width", "a  b", 0, "a  b", "a  b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.input, tt.width); got != tt.want {
+				t.Errorf("Wrap(%q, %d) = %q, want %q", tt.input, tt.width, got, tt.want)
+			}
+			if got := WrapHard(tt.input, tt.width); got != tt.hard {
+				t.Errorf("WrapHard(%q, %d) = %q, want %q", tt.input, tt.width, got, tt.hard)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@
 package timeutil
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -12,6 +15,11 @@ const (
 	FormatDateTime  = "2006-01-02 15:04:05"
 	FormatDateTimeT = "2006-01-02T15:04:05"
 	FormatISO8601   = "2006-01-02T15:04:05Z07:00"
+	FormatReadable  = "Jan 2, 2006 3:04 PM"
+
+	// Web and logging formats
+	FormatRFC1123     = time.RFC1123
+	FormatRFC3339Nano = time.RFC3339Nano
 )
 
 // Now returns the current time
@@ -34,11 +42,69 @@ func Format(t time.Time, format string) string {
 	return t.Format(format)
 }
 
+// FormatFriendly formats a time using FormatReadable (e.g., "Jan 2, 2006 3:04 PM")
+func FormatFriendly(t time.Time) string {
+	return t.Format(FormatReadable)
+}
+
+// FormatDateOnly formats a time using FormatDate
+func FormatDateOnly(t time.Time) string {
+	return t.Format(FormatDate)
+}
+
+// FormatTimeOnly formats a time using FormatTime
+func FormatTimeOnly(t time.Time) string {
+	return t.Format(FormatTime)
+}
+
+// presetLayouts maps preset names accepted by FormatWithPreset to layouts
+var presetLayouts = map[string]string{
+	"date":        FormatDate,
+	"time":        FormatTime,
+	"datetime":    FormatDateTime,
+	"datetime-t":  FormatDateTimeT,
+	"iso8601":     FormatISO8601,
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": FormatRFC3339Nano,
+	"rfc1123":     FormatRFC1123,
+	"friendly":    FormatReadable,
+}
+
+// FormatWithPreset formats a time using a named preset instead of a raw layout
+// Supported presets are "date", "time", "datetime", "datetime-t", "iso8601", "rfc3339", "rfc3339nano",
+// "rfc1123" and "friendly" (case-insensitive)
+func FormatWithPreset(t time.Time, preset string) (string, error) {
+	layout, ok := presetLayouts[strings.ToLower(preset)]
+	if !ok {
+		return "", fmt.Errorf("unknown format preset: %s", preset)
+	}
+	return t.Format(layout), nil
+}
+
 // Parse parses a string to time.Time using the given format
 func Parse(timeStr, format string) (time.Time, error) {
 	return time.Parse(format, timeStr)
 }
 
+// httpDateLayouts lists the date formats allowed in HTTP headers, in order of preference
+var httpDateLayouts = []string{time.RFC1123, time.RFC1123Z, time.RFC850}
+
+// ParseHTTPDate parses an HTTP date header value, trying RFC1123, RFC1123Z and RFC850 in order
+// The error from the RFC1123 attempt is returned if no layout matches
+func ParseHTTPDate(s string) (time.Time, error) {
+	var firstErr error
+	for _, layout := range httpDateLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return time.Time{}, firstErr
+}
+
 // ParseInLocation parses a string to time.Time in a specific location
 func ParseInLocation(timeStr, format string, loc *time.Location) (time.Time, error) {
 	return time.ParseInLocation(format, timeStr, loc)
@@ -72,22 +138,23 @@ func EndOfDay(t time.Time) time.Time {
 
 // StartOfWeek returns the start of the week (Monday) for the given time
 func StartOfWeek(t time.Time) time.Time {
-	weekday := int(t.Weekday())
-	if weekday == 0 {
-		weekday = 7 // Sunday becomes 7
-	}
-	daysToMonday := weekday - 1
-	return StartOfDay(t.AddDate(0, 0, -daysToMonday))
+	return StartOfWeekOn(t, time.Monday)
 }
 
 // EndOfWeek returns the end of the week (Sunday) for the given time
 func EndOfWeek(t time.Time) time.Time {
-	weekday := int(t.Weekday())
-	if weekday == 0 {
-		weekday = 7
-	}
-	daysToSunday := 7 - weekday
-	return EndOfDay(t.AddDate(0, 0, daysToSunday))
+	return EndOfWeekOn(t, time.Monday)
+}
+
+// StartOfWeekOn returns the start of the week for the given time, with weeks starting on firstDay
+func StartOfWeekOn(t time.Time, firstDay time.Weekday) time.Time {
+	daysSinceStart := (int(t.Weekday()) - int(firstDay) + 7) % 7
+	return StartOfDay(t.AddDate(0, 0, -daysSinceStart))
+}
+
+// EndOfWeekOn returns the end of the week for the given time, with weeks starting on firstDay
+func EndOfWeekOn(t time.Time, firstDay time.Weekday) time.Time {
+	return EndOfDay(StartOfWeekOn(t, firstDay).AddDate(0, 0, 6))
 }
 
 // StartOfMonth returns the start of the month for the given time
@@ -110,6 +177,16 @@ func EndOfYear(t time.Time) time.Time {
 	return time.Date(t.Year(), 12, 31, 23, 59, 59, 999999999, t.Location())
 }
 
+// IsLeapYear checks if a year is a leap year in the Gregorian calendar
+func IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// DaysInMonth returns the number of days in the given month of a year
+func DaysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
 // AddDays adds days to a time
 func AddDays(t time.Time, days int) time.Time {
 	return t.AddDate(0, 0, days)
@@ -152,7 +229,12 @@ func IsSameDay(t1, t2 time.Time) bool {
 
 // IsSameWeek checks if two times are in the same week
 func IsSameWeek(t1, t2 time.Time) bool {
-	s1, s2 := StartOfWeek(t1), StartOfWeek(t2)
+	return IsSameWeekOn(t1, t2, time.Monday)
+}
+
+// IsSameWeekOn checks if two times are in the same week, with weeks starting on firstDay
+func IsSameWeekOn(t1, t2 time.Time, firstDay time.Weekday) bool {
+	s1, s2 := StartOfWeekOn(t1, firstDay), StartOfWeekOn(t2, firstDay)
 	return IsSameDay(s1, s2)
 }
 
@@ -181,3 +263,78 @@ func TimeToUnix(t time.Time) int64 {
 	return t.Unix()
 }
 
+// Age returns the number of full years between birth and now
+func Age(birth time.Time) int {
+	return AgeAt(birth, time.Now())
+}
+
+// AgeAt returns the number of full years between birth and reference
+// A birthday on February 29 is reached on March 1 in non-leap years.
+// Returns 0 if reference is before birth
+func AgeAt(birth, reference time.Time) int {
+	age := reference.Year() - birth.Year()
+	if reference.Month() < birth.Month() ||
+		(reference.Month() == birth.Month() && reference.Day() < birth.Day()) {
+		age--
+	}
+	if age < 0 {
+		return 0
+	}
+	return age
+}
+
+// TruncateTo rounds t down to a multiple of d measured from the start of t's day in its own location
+// Unlike time.Time.Truncate, which works relative to the zero time in UTC, truncating to 15 minutes
+// or to hours always yields wall-clock boundaries in the time's location. Returns t if d <= 0
+func TruncateTo(t time.Time, d time.Duration) time.Time {
+	if d <= 0 {
+		return t
+	}
+	start := StartOfDay(t)
+	offset := t.Sub(start)
+	return start.Add(offset - offset%d)
+}
+
+// RoundTo rounds t to the nearest multiple of d measured from the start of t's day in its own location
+// Halfway values round up. See TruncateTo for how this differs from time.Time.Round. Returns t if d <= 0
+func RoundTo(t time.Time, d time.Duration) time.Time {
+	if d <= 0 {
+		return t
+	}
+	start := StartOfDay(t)
+	offset := t.Sub(start) + d/2
+	return start.Add(offset - offset%d)
+}
+
+// SleepUntil pauses the current goroutine until t, returning immediately if t is in the past
+func SleepUntil(t time.Time) {
+	time.Sleep(time.Until(t))
+}
+
+// SleepUntilCtx pauses until t or until ctx is done, whichever comes first
+// Returns ctx.Err() if ctx is done before t, or nil otherwise
+func SleepUntilCtx(ctx context.Context, t time.Time) error {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// NextOccurrence returns the next time after now at the given time of day in loc (time.Local if nil)
+// This is today if that time has not passed yet, and tomorrow otherwise
+func NextOccurrence(hour, minute, second int, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.Local
+	}
+	now := time.Now().In(loc)
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, second, 0, loc)
+	if !next.After(now) {
+		next = time.Date(now.Year(), now.Month(), now.Day()+1, hour, minute, second, 0, loc)
+	}
+	return next
+}
@@ -0,0 +1,35 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAgeAt(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+	leapBirth := date(2000, time.February, 29)
+
+	tests := []struct {
+		name      string
+		birth     time.Time
+		reference time.Time
+		want      int
+	}{
+		{"leap birthday, Feb 28 of non-leap year", leapBirth, date(2023, time.February, 28), 22},
+		{"leap birthday, Mar 1 of non-leap year", leapBirth, date(2023, time.March, 1), 23},
+		{"leap birthday, Feb 28 of leap year", leapBirth, date(2024, time.February, 28), 23},
+		{"leap birthday, Feb 29 of leap year", leapBirth, date(2024, time.February, 29), 24},
+		{"leap birthday, Mar 1 of leap year", leapBirth, date(2024, time.March, 1), 24},
+		{"day before birthday", date(1990, time.December, 31), date(2020, time.December, 30), 29},
+		{"on birthday", date(1990, time.December, 31), date(2020, time.December, 31), 30},
+		{"reference before birth", date(2020, time.June, 1), date(2019, time.June, 1), 0},
+	}
+
+	for _, tt := range tests {
+		if got := AgeAt(tt.birth, tt.reference); got != tt.want {
+			t.Errorf("%s: AgeAt = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}